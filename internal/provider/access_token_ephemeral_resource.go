@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &accessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &accessTokenEphemeralResource{}
+)
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accessTokenEphemeralResource{}
+}
+
+type accessTokenEphemeralResource struct {
+	data *providerData
+}
+
+type accessTokenEphemeralResourceModel struct {
+	Scopes      types.List   `tfsdk:"scopes"`
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenType   types.String `tfsdk:"token_type"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func (r *accessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *accessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Access token for the tenant, requested with the provider's client credentials, for other providers and provisioners calling the SailPoint APIs during the run. The token is never stored in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Scopes requested for the token. The client's default scopes are used when omitted. Requires client credentials, a provider configured with an access_token can only hand out that token.",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access token.",
+			},
+			"token_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the token, usually Bearer.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the token expires, in RFC 3339 format. Null when unknown.",
+			},
+		},
+	}
+}
+
+func (r *accessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Access Token ephemeral resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.data = data
+}
+
+func (r *accessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Info(ctx, "Opening Access Token")
+	var state accessTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopes := make([]string, 0)
+	if !state.Scopes.IsNull() {
+		resp.Diagnostics.Append(state.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	token, err := r.data.newAccessToken(ctx, scopes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Request SailPoint Access Token",
+			err.Error(),
+		)
+		return
+	}
+
+	state.AccessToken = types.StringValue(token.AccessToken)
+	state.TokenType = types.StringValue(token.Type())
+	state.ExpiresAt = types.StringNull()
+	if !token.Expiry.IsZero() {
+		state.ExpiresAt = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &state)...)
+}
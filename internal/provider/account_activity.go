@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+// waitAccountActivity polls the account activities API until the activity
+// completes, which it does successfully with the SUCCESS completion status.
+// Activities are only readable once indexed, so a missing activity is
+// pending. task describes the activity as in pollTask.
+func waitAccountActivity(ctx context.Context, client *sailpoint.APIClient, task string, activityID string) (string, *http.Response, error) {
+	return pollTask(ctx, task, func(ctx context.Context) (taskPoll, *http.Response, error) {
+		activity, res, err := client.V2025.AccountActivitiesAPI.GetAccountActivity(ctx, activityID).Execute()
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return taskPoll{Status: taskStatusPending}, res, nil
+		}
+		if err != nil {
+			return taskPoll{}, res, err
+		}
+
+		execution := activity.GetExecutionStatus()
+		completion := activity.GetCompletionStatus()
+		switch {
+		case execution == api_v2025.EXECUTIONSTATUS_TERMINATED:
+			return taskPoll{}, res, &taskFailedError{Task: task, Status: string(execution)}
+		case execution != api_v2025.EXECUTIONSTATUS_COMPLETED:
+			return taskPoll{Status: string(execution)}, res, nil
+		case completion == api_v2025.COMPLETIONSTATUS_SUCCESS:
+			return taskPoll{Status: string(completion), Done: true}, res, nil
+		case completion == "" || completion == api_v2025.COMPLETIONSTATUS_PENDING:
+			return taskPoll{Status: taskStatusPending}, res, nil
+		}
+
+		status := string(completion)
+		if messages := activity.GetErrors(); len(messages) > 0 {
+			status += ": " + strings.Join(messages, "; ")
+		}
+		return taskPoll{}, res, &taskFailedError{Task: task, Status: status}
+	})
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestWaitAccountActivity(t *testing.T) {
+	fake := newFakeSailPoint(t)
+	fake.respond(http.MethodGet, "/v2025/account-activities/a1", http.StatusOK, `{"id": "a1", "executionStatus": "COMPLETED", "completionStatus": "SUCCESS"}`)
+	fake.respond(http.MethodGet, "/v2025/account-activities/a2", http.StatusOK, `{"id": "a2", "executionStatus": "COMPLETED", "completionStatus": "FAILURE", "errors": ["Account not found on source"]}`)
+	client := fake.providerData().client()
+
+	status, _, err := waitAccountActivity(context.Background(), client, "Account activity a1", "a1")
+	if err != nil || status != "SUCCESS" {
+		t.Errorf("expected SUCCESS, got %q, %v", status, err)
+	}
+
+	_, _, err = waitAccountActivity(context.Background(), client, "Account activity a2", "a2")
+	var failed *taskFailedError
+	if !errors.As(err, &failed) || failed.Status != "FAILURE: Account not found on source" {
+		t.Errorf("expected the activity to fail, got %v", err)
+	}
+}
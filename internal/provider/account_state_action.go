@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const accountStateDefaultTimeout = 10 * time.Minute
+
+// Operations of the account state action.
+const (
+	accountStateEnable  = "ENABLE"
+	accountStateDisable = "DISABLE"
+	accountStateUnlock  = "UNLOCK"
+)
+
+// accountStateOperationPattern matches the operations of the account state
+// action.
+var accountStateOperationPattern = regexp.MustCompile(`^(ENABLE|DISABLE|UNLOCK)$`)
+
+var (
+	_ action.Action                   = &accountStateAction{}
+	_ action.ActionWithConfigure      = &accountStateAction{}
+	_ action.ActionWithValidateConfig = &accountStateAction{}
+)
+
+func NewAccountStateAction() action.Action {
+	return &accountStateAction{}
+}
+
+type accountStateAction struct {
+	data *providerData
+}
+
+type accountStateActionModel struct {
+	AccountID              types.String `tfsdk:"account_id"`
+	Operation              types.String `tfsdk:"operation"`
+	ForceProvisioning      types.Bool   `tfsdk:"force_provisioning"`
+	UnlockIDNAccount       types.Bool   `tfsdk:"unlock_idn_account"`
+	ExternalVerificationID types.String `tfsdk:"external_verification_id"`
+	Wait                   types.Bool   `tfsdk:"wait"`
+	Timeout                types.String `tfsdk:"timeout"`
+}
+
+func (a *accountStateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_state"
+}
+
+func (a *accountStateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables, disables or unlocks an account on its source, as in remediation runbooks, optionally waiting for the account activity of the change to complete.",
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the account.",
+			},
+			"operation": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringPatternValidator{pattern: accountStateOperationPattern, message: "must be one of ENABLE, DISABLE or UNLOCK"}},
+				Description: "Change of the account: ENABLE, DISABLE or UNLOCK.",
+			},
+			"force_provisioning": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to provision the change to the source even when the account is already in the requested state in the tenant, as for accounts out of sync. Defaults to false.",
+			},
+			"unlock_idn_account": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to also unlock the Identity Security Cloud account of the identity once the source account is unlocked. Only valid with UNLOCK. Defaults to false.",
+			},
+			"external_verification_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the verification of the request by an external process, for sources requiring one.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the account activity of the change to complete. Defaults to false.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{durationValidator{}},
+				Description: "How long to wait for the account activity to complete, as a Go duration string (defaults to 10m).",
+			},
+		},
+	}
+}
+
+func (a *accountStateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Account State action")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.data = data
+}
+
+func (a *accountStateAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config accountStateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.UnlockIDNAccount.IsNull() && !config.Operation.IsUnknown() && config.Operation.ValueString() != accountStateUnlock {
+		resp.Diagnostics.AddError(
+			"Invalid Account State Configuration",
+			"unlock_idn_account can only be set with the UNLOCK operation.",
+		)
+	}
+}
+
+func (a *accountStateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Info(ctx, "Invoking Account State")
+	defer a.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var config accountStateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := a.data.client()
+	accountID := config.AccountID.ValueString()
+	operation := config.Operation.ValueString()
+
+	var (
+		result *api_v2025.AccountsAsyncResult
+		res    *http.Response
+		err    error
+	)
+	switch operation {
+	case accountStateUnlock:
+		request := api_v2025.AccountUnlockRequest{
+			ExternalVerificationId: config.ExternalVerificationID.ValueStringPointer(),
+			UnlockIDNAccount:       config.UnlockIDNAccount.ValueBoolPointer(),
+			ForceProvisioning:      config.ForceProvisioning.ValueBoolPointer(),
+		}
+		result, res, err = client.V2025.AccountsAPI.UnlockAccount(ctx, accountID).AccountUnlockRequest(request).Execute()
+	case accountStateEnable, accountStateDisable:
+		request := api_v2025.AccountToggleRequest{
+			ExternalVerificationId: config.ExternalVerificationID.ValueStringPointer(),
+			ForceProvisioning:      config.ForceProvisioning.ValueBoolPointer(),
+		}
+		if operation == accountStateEnable {
+			result, res, err = client.V2025.AccountsAPI.EnableAccount(ctx, accountID).AccountToggleRequest(request).Execute()
+		} else {
+			result, res, err = client.V2025.AccountsAPI.DisableAccount(ctx, accountID).AccountToggleRequest(request).Execute()
+		}
+	}
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, fmt.Sprintf("Unable to %s Account %s", accountStateVerb(operation), accountID), err, res)
+		return
+	}
+
+	activityID := result.GetId()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Sent the %s request of account %s, account activity %s", strings.ToLower(operation), accountID, activityID)})
+	if !config.Wait.ValueBool() {
+		return
+	}
+
+	timeout := timeoutValue(config.Timeout, accountStateDefaultTimeout)
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	task := "Account activity " + activityID
+	status, res, err := waitAccountActivity(pollCtx, client, task, activityID)
+	if err != nil {
+		addTaskPollError(ctx, &resp.Diagnostics, "Account "+accountStateVerb(operation), task, timeout, status, err, res)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Account activity %s completed with status %s", activityID, status)})
+}
+
+// accountStateVerb names an operation in diagnostic summaries.
+func accountStateVerb(operation string) string {
+	switch operation {
+	case accountStateEnable:
+		return "Enable"
+	case accountStateDisable:
+		return "Disable"
+	}
+	return "Unlock"
+}
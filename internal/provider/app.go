@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	appAccountSourceAttrTypes = map[string]attr.Type{
+		"id":                          types.StringType,
+		"type":                        types.StringType,
+		"name":                        types.StringType,
+		"use_for_password_management": types.BoolType,
+	}
+	appAccessProfileAttrTypes = map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"disabled":    types.BoolType,
+		"requestable": types.BoolType,
+	}
+	appDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the source app.",
+		},
+		"cloud_app_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the cloud app the source app is built on.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the app.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the app.",
+		},
+		"enabled": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the app is enabled.",
+		},
+		"provision_request_enabled": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether users can request access to the app.",
+		},
+		"match_all_accounts": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether every account of the account source is matched to the app, instead of only those with its access.",
+		},
+		"app_center_enabled": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the app is shown in the app center of users.",
+		},
+		"account_source": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: appAccountSourceAttrTypes,
+			Description:    "Source whose accounts the app is matched to.",
+		},
+		"owner": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: namedReferenceAttrTypes,
+			Description:    "Owner of the app.",
+		},
+		"access_profiles": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: appAccessProfileAttrTypes},
+			Description: "Access profiles assigned to the app, only set when include_access_profiles is enabled",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the app.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the app.",
+		},
+	}
+)
+
+type appAccountSourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Type                     types.String `tfsdk:"type"`
+	Name                     types.String `tfsdk:"name"`
+	UseForPasswordManagement types.Bool   `tfsdk:"use_for_password_management"`
+}
+
+type appAccessProfileModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Requestable types.Bool   `tfsdk:"requestable"`
+}
+
+type appModel struct {
+	ID                      types.String `tfsdk:"id"`
+	CloudAppID              types.String `tfsdk:"cloud_app_id"`
+	Name                    types.String `tfsdk:"name"`
+	Description             types.String `tfsdk:"description"`
+	Enabled                 types.Bool   `tfsdk:"enabled"`
+	ProvisionRequestEnabled types.Bool   `tfsdk:"provision_request_enabled"`
+	MatchAllAccounts        types.Bool   `tfsdk:"match_all_accounts"`
+	AppCenterEnabled        types.Bool   `tfsdk:"app_center_enabled"`
+	AccountSource           types.Object `tfsdk:"account_source"`
+	Owner                   types.Object `tfsdk:"owner"`
+	AccessProfiles          types.List   `tfsdk:"access_profiles"`
+	Created                 rfc3339Value `tfsdk:"created"`
+	Modified                rfc3339Value `tfsdk:"modified"`
+}
+
+type appsDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters               types.String `tfsdk:"sorters"`
+	Filters               types.String `tfsdk:"filters"`
+	IncludeAccessProfiles types.Bool   `tfsdk:"include_access_profiles"`
+	Apps                  []appModel   `tfsdk:"apps"`
+}
+
+func serializeAppData(ctx context.Context, app api_v2025.SourceApp) (appModel, diag.Diagnostics) {
+	accountSource := types.ObjectNull(appAccountSourceAttrTypes)
+	if source := app.AccountSource.Get(); source != nil {
+		var diags diag.Diagnostics
+		accountSource, diags = types.ObjectValueFrom(ctx, appAccountSourceAttrTypes, appAccountSourceModel{
+			ID:                       types.StringPointerValue(source.Id),
+			Type:                     types.StringPointerValue(source.Type),
+			Name:                     types.StringPointerValue(source.Name),
+			UseForPasswordManagement: types.BoolPointerValue(source.UseForPasswordManagement),
+		})
+		if diags.HasError() {
+			return appModel{}, diags
+		}
+	}
+
+	owner, diags := serializeBaseReference(ctx, app.Owner.Get())
+	if diags.HasError() {
+		return appModel{}, diags
+	}
+
+	return appModel{
+		ID:                      types.StringPointerValue(app.Id),
+		CloudAppID:              types.StringPointerValue(app.CloudAppId),
+		Name:                    types.StringPointerValue(app.Name),
+		Description:             types.StringPointerValue(app.Description),
+		Enabled:                 types.BoolPointerValue(app.Enabled),
+		ProvisionRequestEnabled: types.BoolPointerValue(app.ProvisionRequestEnabled),
+		MatchAllAccounts:        types.BoolPointerValue(app.MatchAllAccounts),
+		AppCenterEnabled:        types.BoolPointerValue(app.AppCenterEnabled),
+		AccountSource:           accountSource,
+		Owner:                   owner,
+		AccessProfiles:          types.ListNull(types.ObjectType{AttrTypes: appAccessProfileAttrTypes}),
+		Created:                 sailPointTimeValue(app.Created),
+		Modified:                sailPointTimeValue(app.Modified),
+	}, nil
+}
+
+func serializeAppAccessProfiles(ctx context.Context, accessProfiles []api_v2025.AccessProfileDetails) (types.List, diag.Diagnostics) {
+	models := make([]appAccessProfileModel, 0, len(accessProfiles))
+	for _, accessProfile := range accessProfiles {
+		models = append(models, appAccessProfileModel{
+			ID:          types.StringPointerValue(accessProfile.Id),
+			Name:        types.StringPointerValue(accessProfile.Name),
+			Description: types.StringPointerValue(accessProfile.Description.Get()),
+			Disabled:    types.BoolPointerValue(accessProfile.Disabled),
+			Requestable: types.BoolPointerValue(accessProfile.Requestable),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: appAccessProfileAttrTypes}, models)
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource                   = &appsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &appsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &appsDataSource{}
+)
+
+func NewAppsDataSource() datasource.DataSource {
+	return &appsDataSource{}
+}
+
+type appsDataSource struct {
+	data *providerData
+}
+
+func (d *appsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apps"
+}
+
+func (d *appsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the source apps of the tenant, the apps users request access to in the app center.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters",
+			},
+			"include_access_profiles": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to fetch the access profiles assigned to each app (one extra request per app)",
+			},
+			"apps": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Source apps of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: appDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *appsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Apps data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// ValidateConfig rejects the data source at plan time when the provider
+// doesn't enable experimental APIs.
+func (d *appsDataSource) ValidateConfig(_ context.Context, _ datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	d.data.requireExperimental("sailpoint_apps", &resp.Diagnostics)
+}
+
+func (d *appsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Apps")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state appsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !d.data.experimentalEnabled(client, "sailpoint_apps", &resp.Diagnostics) {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Apps filters", map[string]any{"filters": filters})
+
+	request := client.V2025.AppsAPI.ListAllSourceApp(ctx)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+	if !state.Sorters.IsNull() {
+		request = request.Sorters(state.Sorters.ValueString())
+	}
+
+	results, res, err := paginate[v2025.SourceApp](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Apps", err, res)
+		return
+	}
+
+	state.Apps = make([]appModel, 0, len(results))
+	for _, app := range results {
+		appState, diags := serializeAppData(ctx, app)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Apps = append(state.Apps, appState)
+	}
+
+	if state.IncludeAccessProfiles.ValueBool() {
+		accessProfiles := make([][]v2025.AccessProfileDetails, len(state.Apps))
+		responses := make([]*http.Response, len(state.Apps))
+		failed, err := forEachConcurrently(ctx, len(state.Apps), detailFetchWorkers, func(ctx context.Context, i int) error {
+			var err error
+			accessProfiles[i], responses[i], err = sailpoint.PaginateWithDefaults[v2025.AccessProfileDetails](client.V2025.AppsAPI.ListAccessProfilesForSourceApp(ctx, state.Apps[i].ID.ValueString()))
+			return err
+		})
+		if err != nil {
+			var res *http.Response
+			appID := ""
+			if failed >= 0 {
+				res, appID = responses[failed], state.Apps[failed].ID.ValueString()
+			}
+			resp.Diagnostics.AddError(
+				"Unable to Read Apps",
+				fmt.Sprintf("Reading access profiles of app %s: %s", appID, describeAPIError(ctx, "Unable to Read Apps", err, res)),
+			)
+			return
+		}
+
+		for i := range state.Apps {
+			var diags diag.Diagnostics
+			state.Apps[i].AccessProfiles, diags = serializeAppAccessProfiles(ctx, accessProfiles[i])
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
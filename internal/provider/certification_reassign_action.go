@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const (
+	certificationReassignDefaultTimeout = 10 * time.Minute
+	// certificationReassignBatchSize is the maximum number of items the
+	// reassign endpoint accepts at once.
+	certificationReassignBatchSize = 500
+
+	certificationTaskStatusSuccess = "SUCCESS"
+	certificationTaskStatusError   = "ERROR"
+)
+
+var (
+	_ action.Action              = &certificationReassignAction{}
+	_ action.ActionWithConfigure = &certificationReassignAction{}
+)
+
+// certificationReassignTypePattern matches the types of the certification
+// items which can be reassigned.
+var certificationReassignTypePattern = regexp.MustCompile(`^(TARGET_SUMMARY|ITEM|IDENTITY_SUMMARY)$`)
+
+func NewCertificationReassignAction() action.Action {
+	return &certificationReassignAction{}
+}
+
+type certificationReassignAction struct {
+	data *providerData
+}
+
+type certificationReassignActionModel struct {
+	CertificationID types.String                     `tfsdk:"certification_id"`
+	ReassignTo      types.String                     `tfsdk:"reassign_to"`
+	Reason          types.String                     `tfsdk:"reason"`
+	Items           []certificationReassignItemModel `tfsdk:"items"`
+	Timeout         types.String                     `tfsdk:"timeout"`
+}
+
+type certificationReassignItemModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+func (a *certificationReassignAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certification_reassign"
+}
+
+func (a *certificationReassignAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reassigns items of an identity campaign certification to another reviewer, as when an approver leaves during a campaign, and waits for the reassignment task to complete. Items are sent in batches of 500, the most the API accepts at once.",
+		Attributes: map[string]schema.Attribute{
+			"certification_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the identity campaign certification the items belong to.",
+			},
+			"reassign_to": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the identity the items are reassigned to.",
+			},
+			"reason": schema.StringAttribute{
+				Required:    true,
+				Description: "Comment explaining the reassignment, shown to the new reviewer.",
+			},
+			"items": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Items reassigned.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringPatternValidator{pattern: certificationReassignTypePattern, message: "must be one of TARGET_SUMMARY, ITEM or IDENTITY_SUMMARY"}},
+							Description: "Type of the item, one of TARGET_SUMMARY, ITEM or IDENTITY_SUMMARY.",
+						},
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "ID of the item, or of the identity for summaries.",
+						},
+					},
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{durationValidator{}},
+				Description: "How long to wait for each reassignment task to complete, as a Go duration string (defaults to 10m).",
+			},
+		},
+	}
+}
+
+func (a *certificationReassignAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Certification Reassign action")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.data = data
+}
+
+func (a *certificationReassignAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Info(ctx, "Invoking Certification Reassign")
+	defer a.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var config certificationReassignActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := a.data.client()
+	certificationID := config.CertificationID.ValueString()
+	timeout := timeoutValue(config.Timeout, certificationReassignDefaultTimeout)
+
+	for start := 0; start < len(config.Items); start += certificationReassignBatchSize {
+		batch := config.Items[start:min(start+certificationReassignBatchSize, len(config.Items))]
+		references := make([]api_v2025.ReassignReference, 0, len(batch))
+		for _, item := range batch {
+			references = append(references, *api_v2025.NewReassignReference(item.ID.ValueString(), item.Type.ValueString()))
+		}
+
+		reassign := api_v2025.NewReviewReassign(references, config.ReassignTo.ValueString(), config.Reason.ValueString())
+		task, res, err := client.V2025.CertificationsAPI.SubmitReassignCertsAsync(ctx, certificationID).ReviewReassign(*reassign).Execute()
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, "Unable to Reassign Certification Items", err, res)
+			return
+		}
+
+		taskID := task.GetId()
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Reassigning %d items of certification %s, task %s", len(batch), certificationID, taskID),
+		})
+
+		pollCtx, cancel := context.WithTimeout(ctx, timeout)
+		status, res, err := pollTask(pollCtx, "Certification reassignment task "+taskID, func(ctx context.Context) (taskPoll, *http.Response, error) {
+			task, res, err := client.V2025.CertificationsAPI.GetCertificationTask(ctx, taskID).Execute()
+			if err != nil {
+				return taskPoll{}, res, err
+			}
+
+			status := task.GetStatus()
+			if status == certificationTaskStatusError {
+				return taskPoll{}, res, &taskFailedError{Task: "Reassignment task " + taskID, Status: status}
+			}
+			return taskPoll{Status: status, Done: status == certificationTaskStatusSuccess}, res, nil
+		})
+		cancel()
+
+		if err != nil {
+			addTaskPollError(ctx, &resp.Diagnostics, "Certification Reassignment", "Reassignment task "+taskID, timeout, status, err, res)
+			return
+		}
+	}
+}
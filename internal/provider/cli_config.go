@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// cliConfig maps the parts of the SailPoint CLI configuration file
+// (~/.sailpoint/config.yaml) the provider can reuse. The active environment of
+// the CLI isn't used, the provider only reads an explicitly selected one.
+type cliConfig struct {
+	Environments map[string]cliEnvironment `yaml:"environments"`
+}
+
+type cliEnvironment struct {
+	BaseURL string `yaml:"baseurl"`
+	Pat     struct {
+		ClientID     string `yaml:"clientid"`
+		ClientSecret string `yaml:"clientsecret"`
+	} `yaml:"pat"`
+}
+
+// defaultCLIConfigPath returns the location the SailPoint CLI stores its
+// configuration in.
+func defaultCLIConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".sailpoint", "config.yaml"), nil
+}
+
+// readCLIEnvironment reads the CLI configuration file at path and returns the
+// named tenant environment.
+func readCLIEnvironment(path string, name string) (cliEnvironment, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return cliEnvironment{}, err
+	}
+
+	var config cliConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return cliEnvironment{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	environment, ok := config.Environments[name]
+	if !ok {
+		return cliEnvironment{}, fmt.Errorf("environment %q is not defined in %s", name, path)
+	}
+
+	return environment, nil
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadCLIEnvironment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := `activeenvironment: sandbox
+authtype: pat
+environments:
+  sandbox:
+    baseurl: https://sandbox.api.identitynow.com
+    tenanturl: https://sandbox.identitynow.com
+    pat:
+      clientid: sandbox-id
+      clientsecret: sandbox-secret
+  production:
+    baseurl: https://production.api.identitynow.com
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	environment, err := readCLIEnvironment(path, "sandbox")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if environment.BaseURL != "https://sandbox.api.identitynow.com" {
+		t.Errorf("unexpected base URL %q", environment.BaseURL)
+	}
+	if environment.Pat.ClientID != "sandbox-id" || environment.Pat.ClientSecret != "sandbox-secret" {
+		t.Errorf("unexpected PAT %+v", environment.Pat)
+	}
+
+	environment, err = readCLIEnvironment(path, "production")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if environment.Pat.ClientID != "" {
+		t.Errorf("expected no PAT for production, got %+v", environment.Pat)
+	}
+
+	if _, err := readCLIEnvironment(path, "missing"); err == nil {
+		t.Error("expected an error for an undefined environment")
+	}
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	configurationHubBackupDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the backup.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the backup.",
+		},
+		"status": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the backup job, such as COMPLETE or FAILED.",
+		},
+		"type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the job, BACKUP.",
+		},
+		"backup_type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "How the backup was created (MANUAL, AUTOMATED, AUTOMATED_DRAFT or UPLOADED)",
+		},
+		"tenant": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Tenant the backup was taken from.",
+		},
+		"requester_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the identity which requested the backup.",
+		},
+		"file_exists": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the backup file is still stored.",
+		},
+		"user_can_delete": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the backup can be deleted by users.",
+		},
+		"is_partial": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the backup only contains some of the supported object types",
+		},
+		"hydration_status": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Whether the objects of the backup are loaded for comparison, such as HYDRATED or NOT_HYDRATED.",
+		},
+		"total_object_count": dataSchema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of objects in the backup.",
+		},
+		"cloud_storage_status": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the copy of the backup in the cloud storage, such as SYNCED.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the backup.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the backup.",
+		},
+		"completed": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Completion date of the backup job.",
+		},
+	}
+	configurationHubDraftDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the draft.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the draft.",
+		},
+		"status": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the draft job, such as COMPLETE or FAILED.",
+		},
+		"type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the job, DRAFT.",
+		},
+		"message": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Message of the draft job, such as the reason of its failure.",
+		},
+		"requester_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the identity which requested the draft.",
+		},
+		"file_exists": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the draft file is still stored.",
+		},
+		"source_tenant": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Tenant the objects of the draft come from.",
+		},
+		"source_backup_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the backup the draft was generated from.",
+		},
+		"source_backup_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the backup the draft was generated from.",
+		},
+		"mode": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Origin of the backup the draft was generated from (RESTORE, PROMOTE or UPLOAD)",
+		},
+		"approval_status": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Approval status used to determine whether the draft can be deployed",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the draft.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the draft.",
+		},
+		"completed": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Completion date of the draft job.",
+		},
+	}
+)
+
+type configurationHubBackupModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Status             types.String `tfsdk:"status"`
+	Type               types.String `tfsdk:"type"`
+	BackupType         types.String `tfsdk:"backup_type"`
+	Tenant             types.String `tfsdk:"tenant"`
+	RequesterName      types.String `tfsdk:"requester_name"`
+	FileExists         types.Bool   `tfsdk:"file_exists"`
+	UserCanDelete      types.Bool   `tfsdk:"user_can_delete"`
+	IsPartial          types.Bool   `tfsdk:"is_partial"`
+	HydrationStatus    types.String `tfsdk:"hydration_status"`
+	TotalObjectCount   types.Int64  `tfsdk:"total_object_count"`
+	CloudStorageStatus types.String `tfsdk:"cloud_storage_status"`
+	Created            rfc3339Value `tfsdk:"created"`
+	Modified           rfc3339Value `tfsdk:"modified"`
+	Completed          rfc3339Value `tfsdk:"completed"`
+}
+
+type configurationHubBackupsDataSourceModel struct {
+	APIVersion types.String                  `tfsdk:"api_version"`
+	Filters    types.String                  `tfsdk:"filters"`
+	Backups    []configurationHubBackupModel `tfsdk:"backups"`
+}
+
+type configurationHubDraftModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Status           types.String `tfsdk:"status"`
+	Type             types.String `tfsdk:"type"`
+	Message          types.String `tfsdk:"message"`
+	RequesterName    types.String `tfsdk:"requester_name"`
+	FileExists       types.Bool   `tfsdk:"file_exists"`
+	SourceTenant     types.String `tfsdk:"source_tenant"`
+	SourceBackupID   types.String `tfsdk:"source_backup_id"`
+	SourceBackupName types.String `tfsdk:"source_backup_name"`
+	Mode             types.String `tfsdk:"mode"`
+	ApprovalStatus   types.String `tfsdk:"approval_status"`
+	Created          rfc3339Value `tfsdk:"created"`
+	Modified         rfc3339Value `tfsdk:"modified"`
+	Completed        rfc3339Value `tfsdk:"completed"`
+}
+
+type configurationHubDraftsDataSourceModel struct {
+	APIVersion types.String                 `tfsdk:"api_version"`
+	Filters    types.String                 `tfsdk:"filters"`
+	Drafts     []configurationHubDraftModel `tfsdk:"drafts"`
+}
+
+func serializeConfigurationHubBackupData(backup api_v2025.BackupResponse1) configurationHubBackupModel {
+	return configurationHubBackupModel{
+		ID:                 types.StringPointerValue(backup.JobId),
+		Name:               types.StringPointerValue(backup.Name),
+		Status:             types.StringPointerValue(backup.Status),
+		Type:               types.StringPointerValue(backup.Type),
+		BackupType:         types.StringPointerValue(backup.BackupType),
+		Tenant:             types.StringPointerValue(backup.Tenant),
+		RequesterName:      types.StringPointerValue(backup.RequesterName),
+		FileExists:         types.BoolPointerValue(backup.FileExists),
+		UserCanDelete:      types.BoolPointerValue(backup.UserCanDelete),
+		IsPartial:          types.BoolPointerValue(backup.IsPartial),
+		HydrationStatus:    types.StringPointerValue(backup.HydrationStatus),
+		TotalObjectCount:   types.Int64PointerValue(backup.TotalObjectCount),
+		CloudStorageStatus: types.StringPointerValue(backup.CloudStorageStatus),
+		Created:            sailPointTimeValue(backup.Created),
+		Modified:           sailPointTimeValue(backup.Modified),
+		Completed:          sailPointTimeValue(backup.Completed),
+	}
+}
+
+func serializeConfigurationHubDraftData(draft api_v2025.DraftResponse) configurationHubDraftModel {
+	return configurationHubDraftModel{
+		ID:               types.StringPointerValue(draft.JobId),
+		Name:             types.StringPointerValue(draft.Name),
+		Status:           types.StringPointerValue(draft.Status),
+		Type:             types.StringPointerValue(draft.Type),
+		Message:          types.StringPointerValue(draft.Message),
+		RequesterName:    types.StringPointerValue(draft.RequesterName),
+		FileExists:       types.BoolPointerValue(draft.FileExists),
+		SourceTenant:     types.StringPointerValue(draft.SourceTenant),
+		SourceBackupID:   types.StringPointerValue(draft.SourceBackupId),
+		SourceBackupName: types.StringPointerValue(draft.SourceBackupName),
+		Mode:             types.StringPointerValue(draft.Mode),
+		ApprovalStatus:   types.StringPointerValue(draft.ApprovalStatus),
+		Created:          sailPointTimeValue(draft.Created),
+		Modified:         sailPointTimeValue(draft.Modified),
+		Completed:        sailPointTimeValue(draft.Completed),
+	}
+}
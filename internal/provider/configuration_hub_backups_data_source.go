@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &configurationHubBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &configurationHubBackupsDataSource{}
+)
+
+func NewConfigurationHubBackupsDataSource() datasource.DataSource {
+	return &configurationHubBackupsDataSource{}
+}
+
+type configurationHubBackupsDataSource struct {
+	data *providerData
+}
+
+func (d *configurationHubBackupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configuration_hub_backups"
+}
+
+func (d *configurationHubBackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the configuration hub backups of the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters (supports status eq)",
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Configuration hub backups of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: configurationHubBackupDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *configurationHubBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ConfigurationHubBackups data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *configurationHubBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Configuration Hub Backups")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state configurationHubBackupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Configuration Hub Backups filters", map[string]any{"filters": filters})
+
+	request := client.V2025.ConfigurationHubAPI.ListBackups(ctx)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+
+	results, res, err := request.Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Configuration Hub Backups", err, res)
+		return
+	}
+
+	state.Backups = make([]configurationHubBackupModel, 0, len(results))
+	for _, backup := range results {
+		state.Backups = append(state.Backups, serializeConfigurationHubBackupData(backup))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
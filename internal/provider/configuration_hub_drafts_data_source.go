@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &configurationHubDraftsDataSource{}
+	_ datasource.DataSourceWithConfigure = &configurationHubDraftsDataSource{}
+)
+
+func NewConfigurationHubDraftsDataSource() datasource.DataSource {
+	return &configurationHubDraftsDataSource{}
+}
+
+type configurationHubDraftsDataSource struct {
+	data *providerData
+}
+
+func (d *configurationHubDraftsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configuration_hub_drafts"
+}
+
+func (d *configurationHubDraftsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the configuration hub drafts of the tenant, the changes generated from backups waiting to be deployed.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters (supports status eq and approvalStatus eq)",
+			},
+			"drafts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Configuration hub drafts of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: configurationHubDraftDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *configurationHubDraftsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ConfigurationHubDrafts data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *configurationHubDraftsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Configuration Hub Drafts")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state configurationHubDraftsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Configuration Hub Drafts filters", map[string]any{"filters": filters})
+
+	request := client.V2025.ConfigurationHubAPI.ListDrafts(ctx)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+
+	results, res, err := request.Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Configuration Hub Drafts", err, res)
+		return
+	}
+
+	state.Drafts = make([]configurationHubDraftModel, 0, len(results))
+	for _, draft := range results {
+		state.Drafts = append(state.Drafts, serializeConfigurationHubDraftData(draft))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
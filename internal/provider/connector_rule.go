@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	connectorRuleArgumentAttrTypes = map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+		"type":        types.StringType,
+	}
+	connectorRuleDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the rule.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the rule.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the rule.",
+		},
+		"type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the rule, such as BuildMap or ConnectorAfterCreate.",
+		},
+		"signature_input": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: connectorRuleArgumentAttrTypes},
+			Description: "Arguments passed to the rule when it is executed",
+		},
+		"signature_output": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: connectorRuleArgumentAttrTypes,
+			Description:    "Value returned by the rule",
+		},
+		"source_code_version": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Version of the source code of the rule.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the rule.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the rule.",
+		},
+	}
+)
+
+type connectorRuleArgumentModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+}
+
+type connectorRuleModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Type              types.String `tfsdk:"type"`
+	SignatureInput    types.List   `tfsdk:"signature_input"`
+	SignatureOutput   types.Object `tfsdk:"signature_output"`
+	SourceCodeVersion types.String `tfsdk:"source_code_version"`
+	Created           rfc3339Value `tfsdk:"created"`
+	Modified          rfc3339Value `tfsdk:"modified"`
+}
+
+type connectorRulesDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Type           types.String         `tfsdk:"type"`
+	ConnectorRules []connectorRuleModel `tfsdk:"connector_rules"`
+}
+
+func serializeConnectorRuleArgument(argument api_v2025.Argument) connectorRuleArgumentModel {
+	return connectorRuleArgumentModel{
+		Name:        types.StringValue(argument.Name),
+		Description: types.StringPointerValue(argument.Description.Get()),
+		Type:        types.StringPointerValue(argument.Type.Get()),
+	}
+}
+
+func serializeConnectorRuleData(ctx context.Context, rule api_v2025.ConnectorRuleResponse) (connectorRuleModel, diag.Diagnostics) {
+	input := make([]connectorRuleArgumentModel, 0)
+	output := types.ObjectNull(connectorRuleArgumentAttrTypes)
+	if rule.Signature != nil {
+		for _, argument := range rule.Signature.Input {
+			input = append(input, serializeConnectorRuleArgument(argument))
+		}
+
+		if argument := rule.Signature.Output.Get(); argument != nil {
+			var diags diag.Diagnostics
+			output, diags = types.ObjectValueFrom(ctx, connectorRuleArgumentAttrTypes, serializeConnectorRuleArgument(*argument))
+			if diags.HasError() {
+				return connectorRuleModel{}, diags
+			}
+		}
+	}
+
+	signatureInput, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: connectorRuleArgumentAttrTypes}, input)
+	if diags.HasError() {
+		return connectorRuleModel{}, diags
+	}
+
+	return connectorRuleModel{
+		ID:                types.StringValue(rule.Id),
+		Name:              types.StringValue(rule.Name),
+		Description:       types.StringPointerValue(rule.Description.Get()),
+		Type:              types.StringValue(rule.Type),
+		SignatureInput:    signatureInput,
+		SignatureOutput:   output,
+		SourceCodeVersion: types.StringValue(rule.SourceCode.Version),
+		Created:           rfc3339StringValue(&rule.Created),
+		Modified:          rfc3339StringValue(rule.Modified.Get()),
+	}, nil
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &connectorRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectorRulesDataSource{}
+)
+
+func NewConnectorRulesDataSource() datasource.DataSource {
+	return &connectorRulesDataSource{}
+}
+
+type connectorRulesDataSource struct {
+	data *providerData
+}
+
+func (d *connectorRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_rules"
+}
+
+func (d *connectorRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the connector rules of the tenant, the rules run by the connectors of sources on the virtual appliances.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return connector rules of this type (ex. BuildMap, ConnectorAfterCreate)",
+			},
+			"connector_rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Connector rules of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: connectorRuleDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *connectorRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ConnectorRules data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *connectorRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Connector Rules")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state connectorRulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleType := state.Type.ValueString()
+	tflog.Debug(ctx, "Reading Connector Rules type", map[string]any{"type": ruleType})
+
+	request := client.V2025.ConnectorRuleManagementAPI.GetConnectorRuleList(ctx)
+
+	results, res, err := paginate[v2025.ConnectorRuleResponse](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Connector Rules", err, res)
+		return
+	}
+
+	state.ConnectorRules = make([]connectorRuleModel, 0, len(results))
+	for _, rule := range results {
+		if ruleType != "" && rule.Type != ruleType {
+			continue
+		}
+
+		ruleState, diags := serializeConnectorRuleData(ctx, rule)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ConnectorRules = append(state.ConnectorRules, ruleState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
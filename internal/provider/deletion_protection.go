@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionResourceSchemaAttribute is the deletion_protection
+// attribute of the resources whose deletion disrupts the tenant.
+var deletionProtectionResourceSchemaAttribute = schema.BoolAttribute{
+	Optional:    true,
+	Description: "Whether to prevent the deletion of the object, including its replacement. Destroying the resource fails while it is true, set it to false and apply first. Unlike the prevent_destroy lifecycle argument, it also applies when the resource is removed from the configuration.",
+}
+
+// deletionProtected reports whether deletion_protection, as recorded in the
+// state, prevents the deletion of an object, adding an error diagnostic when
+// it does.
+func deletionProtected(protection types.Bool, objectType string, id string, diags *diag.Diagnostics) bool {
+	if !protection.ValueBool() {
+		return false
+	}
+
+	diags.AddAttributeError(
+		path.Root("deletion_protection"),
+		"Deletion Protection Enabled",
+		fmt.Sprintf("The %s %s cannot be deleted or replaced while deletion_protection is true. Set deletion_protection = false and apply before destroying it.", objectType, id),
+	)
+	return true
+}
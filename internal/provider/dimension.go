@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	dimensionDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the dimension.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the dimension.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the dimension.",
+		},
+		"parent_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the role the dimension belongs to",
+		},
+		"owner": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: namedReferenceAttrTypes,
+			Description:    "Owner of the dimension.",
+		},
+		"access_profiles": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: namedReferenceAttrTypes},
+			Description: "Access profiles granted by the dimension.",
+		},
+		"entitlements": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: namedReferenceAttrTypes},
+			Description: "Entitlements granted by the dimension.",
+		},
+		"membership_type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "How identities are assigned the dimension, STANDARD for criteria.",
+		},
+		"membership_criteria": dataSchema.StringAttribute{
+			CustomType:  normalizedJSONType{},
+			Computed:    true,
+			Description: "JSON encoded membership criteria, as returned by the API",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the dimension.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the dimension.",
+		},
+	}
+)
+
+type dimensionModel struct {
+	ID                 types.String        `tfsdk:"id"`
+	Name               types.String        `tfsdk:"name"`
+	Description        types.String        `tfsdk:"description"`
+	ParentID           types.String        `tfsdk:"parent_id"`
+	Owner              types.Object        `tfsdk:"owner"`
+	AccessProfiles     types.List          `tfsdk:"access_profiles"`
+	Entitlements       types.List          `tfsdk:"entitlements"`
+	MembershipType     types.String        `tfsdk:"membership_type"`
+	MembershipCriteria normalizedJSONValue `tfsdk:"membership_criteria"`
+	Created            rfc3339Value        `tfsdk:"created"`
+	Modified           rfc3339Value        `tfsdk:"modified"`
+}
+
+type dimensionsDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters    types.String     `tfsdk:"sorters"`
+	RoleID     types.String     `tfsdk:"role_id"`
+	Filters    types.String     `tfsdk:"filters"`
+	Dimensions []dimensionModel `tfsdk:"dimensions"`
+}
+
+func serializeDimensionData(ctx context.Context, dimension api_v2025.Dimension) (dimensionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	owner := types.ObjectNull(namedReferenceAttrTypes)
+	if ref := dimension.Owner.Get(); ref != nil {
+		owner, diags = types.ObjectValueFrom(ctx, namedReferenceAttrTypes, namedReferenceModel{
+			Type: types.StringPointerValue(ref.Type),
+			ID:   types.StringPointerValue(ref.Id),
+			Name: types.StringPointerValue(ref.Name),
+		})
+		if diags.HasError() {
+			return dimensionModel{}, diags
+		}
+	}
+
+	accessProfiles := make([]namedReferenceModel, 0, len(dimension.AccessProfiles))
+	for _, ref := range dimension.AccessProfiles {
+		accessProfiles = append(accessProfiles, namedReferenceModel{
+			Type: types.StringPointerValue(ref.Type),
+			ID:   types.StringPointerValue(ref.Id),
+			Name: types.StringPointerValue(ref.Name),
+		})
+	}
+
+	accessProfilesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: namedReferenceAttrTypes}, accessProfiles)
+	if diags.HasError() {
+		return dimensionModel{}, diags
+	}
+
+	entitlements := make([]namedReferenceModel, 0, len(dimension.Entitlements))
+	for _, ref := range dimension.Entitlements {
+		entitlements = append(entitlements, namedReferenceModel{
+			Type: types.StringPointerValue(ref.Type),
+			ID:   types.StringPointerValue(ref.Id),
+			Name: types.StringPointerValue(ref.Name.Get()),
+		})
+	}
+
+	entitlementsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: namedReferenceAttrTypes}, entitlements)
+	if diags.HasError() {
+		return dimensionModel{}, diags
+	}
+
+	membershipType := types.StringNull()
+	membershipCriteria := normalizedJSONNull()
+	if membership := dimension.Membership.Get(); membership != nil {
+		if membership.Type != nil {
+			membershipType = types.StringValue(string(*membership.Type))
+		}
+
+		if criteria := membership.Criteria.Get(); criteria != nil {
+			criteriaBytes, err := json.Marshal(criteria)
+			if err != nil {
+				diags.AddError(
+					"Unable to serialize dimension membership criteria",
+					err.Error(),
+				)
+				return dimensionModel{}, diags
+			}
+			membershipCriteria = normalizedJSONStringValue(string(criteriaBytes))
+		}
+	}
+
+	return dimensionModel{
+		ID:                 types.StringPointerValue(dimension.Id),
+		Name:               types.StringValue(dimension.Name),
+		Description:        types.StringPointerValue(dimension.Description.Get()),
+		ParentID:           types.StringPointerValue(dimension.ParentId.Get()),
+		Owner:              owner,
+		AccessProfiles:     accessProfilesList,
+		Entitlements:       entitlementsList,
+		MembershipType:     membershipType,
+		MembershipCriteria: membershipCriteria,
+		Created:            sailPointTimeValue(dimension.Created),
+		Modified:           sailPointTimeValue(dimension.Modified),
+	}, nil
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &dimensionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dimensionsDataSource{}
+)
+
+func NewDimensionsDataSource() datasource.DataSource {
+	return &dimensionsDataSource{}
+}
+
+type dimensionsDataSource struct {
+	data *providerData
+}
+
+func (d *dimensionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dimensions"
+}
+
+func (d *dimensionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the dimensions of a dynamic role, which grant its access depending on the attributes of its members.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"role_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the dynamic role the dimensions belong to",
+			},
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters",
+			},
+			"dimensions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Dimensions of the role.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dimensionDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *dimensionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Dimensions data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *dimensionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Dimensions")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state dimensionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := state.RoleID.ValueString()
+	if roleID == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Read Dimensions",
+			"role_id cannot be empty",
+		)
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Dimensions filters", map[string]any{"role_id": roleID, "filters": filters})
+
+	request := client.V2025.DimensionsAPI.ListDimensions(ctx, roleID)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+	if !state.Sorters.IsNull() {
+		request = request.Sorters(state.Sorters.ValueString())
+	}
+
+	results, res, err := paginate[v2025.Dimension](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Dimensions", err, res)
+		return
+	}
+
+	state.Dimensions = make([]dimensionModel, 0, len(results))
+	for _, dimension := range results {
+		dimensionState, diags := serializeDimensionData(ctx, dimension)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Dimensions = append(state.Dimensions, dimensionState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
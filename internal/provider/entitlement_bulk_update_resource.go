@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const (
+	// entitlementBulkUpdateBatchSize is the maximum number of entitlements
+	// the bulk update endpoint accepts at once.
+	entitlementBulkUpdateBatchSize = 50
+	// defaultEntitlementBulkUpdateMaxEntitlements guards against filters
+	// matching much more entitlements than intended.
+	defaultEntitlementBulkUpdateMaxEntitlements = 1000
+
+	entitlementBulkUpdateDefaultTimeout = 20 * time.Minute
+)
+
+var (
+	_ resource.Resource                   = &entitlementBulkUpdateResource{}
+	_ resource.ResourceWithConfigure      = &entitlementBulkUpdateResource{}
+	_ resource.ResourceWithValidateConfig = &entitlementBulkUpdateResource{}
+	_ resource.ResourceWithUpgradeState   = &entitlementBulkUpdateResource{}
+	_ resource.ResourceWithModifyPlan     = &entitlementBulkUpdateResource{}
+)
+
+var entitlementBulkUpdateStateUpgrades []stateUpgrade
+
+func NewEntitlementBulkUpdateResource() resource.Resource {
+	return &entitlementBulkUpdateResource{}
+}
+
+type entitlementBulkUpdateResource struct {
+	client             *sailpoint.APIClient
+	rateLimit          *rateLimitUsage
+	validateReferences bool
+}
+
+type entitlementBulkUpdateResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Filters         types.String   `tfsdk:"filters"`
+	MaxEntitlements types.Int64    `tfsdk:"max_entitlements"`
+	Requestable     types.Bool     `tfsdk:"requestable"`
+	Privileged      types.Bool     `tfsdk:"privileged"`
+	OwnerID         types.String   `tfsdk:"owner_id"`
+	EntitlementIDs  []types.String `tfsdk:"entitlement_ids"`
+	Timeouts        *timeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *entitlementBulkUpdateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entitlement_bulk_update"
+}
+
+func (r *entitlementBulkUpdateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     stateVersion(entitlementBulkUpdateStateUpgrades),
+		Description: "Applies governance attributes to every entitlement matching a filter. Entitlements matching the filter later on, or changed outside of Terraform, show up as a change of the attributes they don't comply with in the next plan. Destroying the resource leaves the entitlements as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Filters selecting the updated entitlements.",
+			},
+			"filters": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter selecting the entitlements, using the standard syntax described in V3 API Standard Collection Parameters (ex. source.id eq \"2c9180835d191a86015d28455b4b232a\").",
+			},
+			"max_entitlements": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64RangeValidator{min: 1}},
+				Description: fmt.Sprintf("Maximum number of entitlements the filter may match, the update fails beyond it to protect against filters broader than intended. Defaults to %d.", defaultEntitlementBulkUpdateMaxEntitlements),
+			},
+			"requestable": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the entitlements can be requested. Left as is when omitted.",
+			},
+			"privileged": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the entitlements are privileged. Left as is when omitted.",
+			},
+			"owner_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the identity owning the entitlements. Left as is when omitted.",
+			},
+			"entitlement_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the entitlements matching the filter.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsResourceSchemaBlock,
+		},
+	}
+}
+
+func (r *entitlementBulkUpdateResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(entitlementBulkUpdateStateUpgrades)
+}
+
+func (r *entitlementBulkUpdateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint EntitlementBulkUpdate resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client()
+	r.rateLimit = data.rateLimit
+	r.validateReferences = data.validateReferences
+}
+
+func (r *entitlementBulkUpdateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing references other objects in destroy plans.
+	if !r.validateReferences || req.Plan.Raw.IsNull() {
+		return
+	}
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	references := planReferences(ctx, req, map[string]referenceKind{
+		"owner_id": identityReference,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkReferences(ctx, r.client, references, &resp.Diagnostics)
+}
+
+func (r *entitlementBulkUpdateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config entitlementBulkUpdateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Requestable.IsNull() && config.Privileged.IsNull() && config.OwnerID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Entitlement Attributes",
+			"At least one of requestable, privileged or owner_id must be set.",
+		)
+	}
+}
+
+func (r *entitlementBulkUpdateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating entitlement bulk update resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan entitlementBulkUpdateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.create(entitlementBulkUpdateDefaultTimeout))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID is the filter the resource was created with.
+	plan.ID = plan.Filters
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *entitlementBulkUpdateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading entitlement bulk update resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var state entitlementBulkUpdateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, state.Timeouts.read(entitlementBulkUpdateDefaultTimeout))
+	defer cancel()
+
+	entitlements, res, err := listEntitlementsByFilter(ctx, r.client, state.Filters.ValueString(), 0)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Entitlements", err, res)
+		return
+	}
+
+	// Attributes which some entitlement doesn't comply with are set to what
+	// that entitlement has, so the next plan applies them again.
+	applied := state
+	state.EntitlementIDs = make([]types.String, 0, len(entitlements))
+	for _, entitlement := range entitlements {
+		state.EntitlementIDs = append(state.EntitlementIDs, types.StringValue(entitlement.GetId()))
+
+		if !applied.Requestable.IsNull() && entitlement.GetRequestable() != applied.Requestable.ValueBool() {
+			state.Requestable = types.BoolValue(entitlement.GetRequestable())
+		}
+		if !applied.Privileged.IsNull() && entitlement.GetPrivileged() != applied.Privileged.ValueBool() {
+			state.Privileged = types.BoolValue(entitlement.GetPrivileged())
+		}
+		if owner := entitlementOwnerID(entitlement); !applied.OwnerID.IsNull() && owner != applied.OwnerID.ValueString() {
+			state.OwnerID = types.StringValue(owner)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *entitlementBulkUpdateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating entitlement bulk update resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan entitlementBulkUpdateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.update(entitlementBulkUpdateDefaultTimeout))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state, the previous values of the
+// attributes are not known.
+func (r *entitlementBulkUpdateResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting entitlement bulk update resource, the entitlements are left as they are")
+}
+
+// apply updates the entitlements matching the filter of plan which don't
+// comply with it, and sets its computed attributes.
+func (r *entitlementBulkUpdateResource) apply(ctx context.Context, plan *entitlementBulkUpdateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	maxEntitlements := defaultEntitlementBulkUpdateMaxEntitlements
+	if !plan.MaxEntitlements.IsNull() {
+		maxEntitlements = int(plan.MaxEntitlements.ValueInt64())
+	}
+
+	entitlements, res, err := listEntitlementsByFilter(ctx, r.client, plan.Filters.ValueString(), maxEntitlements+1)
+	if err != nil {
+		addAPIError(ctx, &diags, "Unable to Read Entitlements", err, res)
+		return diags
+	}
+	if len(entitlements) > maxEntitlements {
+		diags.AddAttributeError(
+			path.Root("filters"),
+			"Too Many Entitlements",
+			fmt.Sprintf("The filter matches more than %d entitlements, narrow it down or raise max_entitlements.", maxEntitlements),
+		)
+		return diags
+	}
+
+	for _, update := range entitlementBulkUpdates(entitlements, plan.Requestable, plan.Privileged) {
+		for start := 0; start < len(update.ids); start += entitlementBulkUpdateBatchSize {
+			batch := update.ids[start:min(start+entitlementBulkUpdateBatchSize, len(update.ids))]
+			tflog.Debug(ctx, "Updating entitlements in bulk", map[string]any{"path": update.operation.Path, "count": len(batch)})
+
+			request := api_v2025.NewEntitlementBulkUpdateRequest(batch, []api_v2025.JsonPatchOperation{update.operation})
+			res, err := r.client.V2025.EntitlementsAPI.UpdateEntitlementsInBulk(ctx).EntitlementBulkUpdateRequest(*request).Execute()
+			if err != nil {
+				addAPIError(ctx, &diags, "Unable to Update Entitlements", err, res)
+				return diags
+			}
+		}
+	}
+
+	// Owners can't be updated in bulk.
+	if !plan.OwnerID.IsNull() {
+		owner := map[string]interface{}{"type": "IDENTITY", "id": plan.OwnerID.ValueString()}
+		operation := api_v2025.NewJsonPatchOperation("replace", "/owner")
+		operation.Value = &api_v2025.UpdateMultiHostSourcesRequestInnerValue{MapmapOfStringAny: &owner}
+
+		ids := make([]string, 0)
+		for _, entitlement := range entitlements {
+			if entitlementOwnerID(entitlement) != plan.OwnerID.ValueString() {
+				ids = append(ids, entitlement.GetId())
+			}
+		}
+
+		responses := make([]*http.Response, len(ids))
+		failed, err := forEachConcurrently(ctx, len(ids), detailFetchWorkers, func(ctx context.Context, i int) error {
+			var err error
+			_, responses[i], err = r.client.V2025.EntitlementsAPI.PatchEntitlement(ctx, ids[i]).JsonPatchOperation([]api_v2025.JsonPatchOperation{*operation}).Execute()
+			return err
+		})
+		if err != nil {
+			if failed < 0 {
+				addAPIError(ctx, &diags, "Unable to Update Entitlement Owners", err, nil)
+				return diags
+			}
+			addAPIError(ctx, &diags, fmt.Sprintf("Unable to Update the Owner of Entitlement %s", ids[failed]), err, responses[failed])
+			return diags
+		}
+	}
+
+	plan.EntitlementIDs = make([]types.String, 0, len(entitlements))
+	for _, entitlement := range entitlements {
+		plan.EntitlementIDs = append(plan.EntitlementIDs, types.StringValue(entitlement.GetId()))
+	}
+
+	return diags
+}
+
+// entitlementBulkUpdate is a patch operation of the bulk update endpoint and
+// the entitlements it applies to.
+type entitlementBulkUpdate struct {
+	operation api_v2025.JsonPatchOperation
+	ids       []string
+}
+
+// entitlementBulkUpdates returns the bulk updates bringing the entitlements
+// which don't comply with the configured requestable and privileged values
+// in line, skipping the attributes every entitlement complies with.
+func entitlementBulkUpdates(entitlements []api_v2025.Entitlement, requestable types.Bool, privileged types.Bool) []entitlementBulkUpdate {
+	updates := make([]entitlementBulkUpdate, 0, 2)
+	for _, attribute := range []struct {
+		path  string
+		value types.Bool
+		get   func(*api_v2025.Entitlement) bool
+	}{
+		{"/requestable", requestable, (*api_v2025.Entitlement).GetRequestable},
+		{"/privileged", privileged, (*api_v2025.Entitlement).GetPrivileged},
+	} {
+		if attribute.value.IsNull() || attribute.value.IsUnknown() {
+			continue
+		}
+
+		ids := make([]string, 0)
+		for _, entitlement := range entitlements {
+			if attribute.get(&entitlement) != attribute.value.ValueBool() {
+				ids = append(ids, entitlement.GetId())
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		value := attribute.value.ValueBool()
+		operation := api_v2025.NewJsonPatchOperation("replace", attribute.path)
+		operation.Value = &api_v2025.UpdateMultiHostSourcesRequestInnerValue{Bool: &value}
+		updates = append(updates, entitlementBulkUpdate{operation: *operation, ids: ids})
+	}
+	return updates
+}
+
+// entitlementOwnerID returns the ID of the owner of an entitlement, empty when
+// it has none.
+func entitlementOwnerID(entitlement api_v2025.Entitlement) string {
+	owner, ok := entitlement.GetOwnerOk()
+	if !ok || owner == nil {
+		return ""
+	}
+	return owner.GetId()
+}
+
+// listEntitlementsByFilter lists the entitlements matching filters, at most
+// maxResults of them, 0 meaning no limit.
+func listEntitlementsByFilter(ctx context.Context, client *sailpoint.APIClient, filters string, maxResults int) ([]api_v2025.Entitlement, *http.Response, error) {
+	pagination := paginationModel{MaxResults: types.Int64Value(int64(maxResults))}
+	request := client.V2025.EntitlementsAPI.ListEntitlements(ctx).Filters(filters)
+	return paginate[api_v2025.Entitlement](request, &pagination)
+}
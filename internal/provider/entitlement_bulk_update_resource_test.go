@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestEntitlementBulkUpdates(t *testing.T) {
+	var entitlements []api_v2025.Entitlement
+	body := `[{"id": "e1", "requestable": true, "privileged": false}, {"id": "e2", "requestable": false, "privileged": false}, {"id": "e3"}]`
+	if err := json.Unmarshal([]byte(body), &entitlements); err != nil {
+		t.Fatal(err)
+	}
+
+	updates := entitlementBulkUpdates(entitlements, types.BoolValue(true), types.BoolValue(false))
+	if len(updates) != 1 {
+		t.Fatalf("expected a single update, got %d", len(updates))
+	}
+	update := updates[0]
+	if update.operation.Path != "/requestable" || !*update.operation.Value.Bool {
+		t.Errorf("unexpected operation %+v", update.operation)
+	}
+	if len(update.ids) != 2 || update.ids[0] != "e2" || update.ids[1] != "e3" {
+		t.Errorf("unexpected entitlements %v", update.ids)
+	}
+
+	if updates := entitlementBulkUpdates(entitlements, types.BoolNull(), types.BoolNull()); len(updates) != 0 {
+		t.Errorf("expected no update, got %d", len(updates))
+	}
+}
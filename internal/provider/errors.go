@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiErrorMessage is a single localized message in a SailPoint error response.
+type apiErrorMessage struct {
+	Locale       string `json:"locale"`
+	LocaleOrigin string `json:"localeOrigin"`
+	Text         string `json:"text"`
+}
+
+// apiError is the error body returned by every SailPoint API version.
+type apiError struct {
+	DetailCode string            `json:"detailCode"`
+	TrackingID string            `json:"trackingId"`
+	Messages   []apiErrorMessage `json:"messages"`
+	Causes     []apiErrorMessage `json:"causes"`
+}
+
+// parseAPIError decodes the SailPoint error body carried by an SDK error. It
+// returns false when err has no body or the body is not a SailPoint error.
+func parseAPIError(err error) (apiError, bool) {
+	var bodyErr interface{ Body() []byte }
+	if !errors.As(err, &bodyErr) {
+		return apiError{}, false
+	}
+
+	var parsed apiError
+	if jsonErr := json.Unmarshal(bodyErr.Body(), &parsed); jsonErr != nil {
+		return apiError{}, false
+	}
+	if parsed.DetailCode == "" && parsed.TrackingID == "" && len(parsed.Messages) == 0 {
+		return apiError{}, false
+	}
+
+	return parsed, true
+}
+
+// apiErrorDetail formats err for use as a diagnostic detail. SailPoint error
+// bodies are rendered as their messages followed by the detail code and
+// tracking ID, which support needs to trace the failing request; anything
+// else falls back to err.Error().
+func apiErrorDetail(err error) string {
+	parsed, ok := parseAPIError(err)
+	if !ok {
+		return err.Error()
+	}
+
+	var lines []string
+	for _, message := range parsed.Messages {
+		if message.Text != "" {
+			lines = append(lines, message.Text)
+		}
+	}
+	for _, cause := range parsed.Causes {
+		if cause.Text != "" {
+			lines = append(lines, "Cause: "+cause.Text)
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, err.Error())
+	}
+	if parsed.DetailCode != "" {
+		lines = append(lines, "Detail code: "+parsed.DetailCode)
+	}
+	if parsed.TrackingID != "" {
+		lines = append(lines, "Tracking ID: "+parsed.TrackingID)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// describeAPIError logs a failed SDK call and returns the diagnostic detail
+// for it. res is nil when the request never produced a response (connection,
+// TLS or token failures), which is reported differently from an error the
+// API returned so users know whether to check their network or their request.
+func describeAPIError(ctx context.Context, summary string, err error, res *http.Response) string {
+	if res == nil {
+		tflog.Error(ctx, summary, map[string]any{"error": err.Error()})
+		return "The provider could not reach the SailPoint API: " + err.Error()
+	}
+
+	fields := map[string]any{"error": err.Error(), "status": res.StatusCode}
+	if res.Body != nil {
+		defer res.Body.Close()
+		bodyBytes, _ := io.ReadAll(res.Body)
+		fields["response_body"] = string(bodyBytes)
+	}
+	tflog.Error(ctx, summary, fields)
+
+	return fmt.Sprintf("The SailPoint API responded with %s.\n\n%s", res.Status, apiErrorDetail(err))
+}
+
+// addAPIError adds an error diagnostic for a failed SDK call. It is safe to
+// call with a nil response.
+func addAPIError(ctx context.Context, diags *diag.Diagnostics, summary string, err error, res *http.Response) {
+	diags.AddError(summary, describeAPIError(ctx, summary, err, res))
+}
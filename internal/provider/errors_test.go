@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testBodyError struct {
+	body []byte
+}
+
+func (e testBodyError) Error() string { return "400 Bad Request" }
+func (e testBodyError) Body() []byte  { return e.body }
+
+func TestAPIErrorDetail(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"structured": {
+			err:  testBodyError{body: []byte(`{"detailCode":"400.1 Bad request content","trackingId":"abc123","messages":[{"locale":"en-US","localeOrigin":"DEFAULT","text":"The request was syntactically correct but its content is semantically invalid."}]}`)},
+			want: "The request was syntactically correct but its content is semantically invalid.\nDetail code: 400.1 Bad request content\nTracking ID: abc123",
+		},
+		"wrapped": {
+			err:  fmt.Errorf("reading: %w", testBodyError{body: []byte(`{"detailCode":"404 Not found","trackingId":"def456"}`)}),
+			want: "reading: 400 Bad Request\nDetail code: 404 Not found\nTracking ID: def456",
+		},
+		"not json": {
+			err:  testBodyError{body: []byte(`<html>bad gateway</html>`)},
+			want: "400 Bad Request",
+		},
+		"plain": {
+			err:  errors.New("dial tcp: connection refused"),
+			want: "dial tcp: connection refused",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := apiErrorDetail(test.err); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAddAPIErrorNilResponse(t *testing.T) {
+	var diags diag.Diagnostics
+	addAPIError(context.Background(), &diags, "Unable to Read Things", errors.New("dial tcp: connection refused"), nil)
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected one error, got %d", diags.ErrorsCount())
+	}
+	if detail := diags[0].Detail(); !strings.HasPrefix(detail, "The provider could not reach the SailPoint API") {
+		t.Errorf("unexpected detail %q", detail)
+	}
+}
+
+func TestAddAPIErrorResponse(t *testing.T) {
+	body := `{"detailCode":"404 Not found","trackingId":"def456"}`
+	res := &http.Response{
+		Status:     "404 Not Found",
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+
+	var diags diag.Diagnostics
+	addAPIError(context.Background(), &diags, "Unable to Read Things", testBodyError{body: []byte(body)}, res)
+
+	want := "The SailPoint API responded with 404 Not Found.\n\n400 Bad Request\nDetail code: 404 Not found\nTracking ID: def456"
+	if detail := diags[0].Detail(); detail != want {
+		t.Errorf("got %q, want %q", detail, want)
+	}
+}
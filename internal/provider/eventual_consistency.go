@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultReadAfterCreateTimeout = 2 * time.Minute
+
+	readAfterCreateWaitMin = 1 * time.Second
+	readAfterCreateWaitMax = 10 * time.Second
+)
+
+// readAfterCreate calls read until it stops failing with 404 Not Found, the
+// API briefly reporting the objects it just created as missing. The wait
+// between attempts doubles from readAfterCreateWaitMin up to
+// readAfterCreateWaitMax, and the last result is returned once timeout
+// elapsed or ctx is done.
+func readAfterCreate[T any](ctx context.Context, timeout time.Duration, read func(ctx context.Context) (T, *http.Response, error)) (T, *http.Response, error) {
+	deadline := time.Now().Add(timeout)
+	wait := readAfterCreateWaitMin
+
+	for attempt := 1; ; attempt++ {
+		value, res, err := read(ctx)
+		if err == nil || res == nil || res.StatusCode != http.StatusNotFound || time.Now().Add(wait).After(deadline) {
+			return value, res, err
+		}
+
+		tflog.Debug(ctx, "Created object not found yet, reading it again", map[string]any{"attempt": attempt, "wait": wait.String()})
+
+		select {
+		case <-ctx.Done():
+			return value, res, err
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, readAfterCreateWaitMax)
+	}
+}
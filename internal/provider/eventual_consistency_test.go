@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestReadAfterCreate(t *testing.T) {
+	errNotFound := errors.New("not found")
+	calls := 0
+
+	value, _, err := readAfterCreate(context.Background(), time.Minute, func(_ context.Context) (string, *http.Response, error) {
+		calls++
+		if calls == 1 {
+			return "", &http.Response{StatusCode: http.StatusNotFound}, errNotFound
+		}
+		return "created", &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	if err != nil || value != "created" || calls != 2 {
+		t.Errorf("got %q after %d calls: %v", value, calls, err)
+	}
+}
+
+func TestReadAfterCreateFailures(t *testing.T) {
+	errFailed := errors.New("failed")
+	tests := map[string]struct {
+		status  int
+		timeout time.Duration
+	}{
+		"other status": {http.StatusForbidden, time.Minute},
+		"timeout":      {http.StatusNotFound, 0},
+	}
+
+	for name, test := range tests {
+		calls := 0
+		_, res, err := readAfterCreate(context.Background(), test.timeout, func(_ context.Context) (string, *http.Response, error) {
+			calls++
+			return "", &http.Response{StatusCode: test.status}, errFailed
+		})
+
+		if !errors.Is(err, errFailed) || res.StatusCode != test.status || calls != 1 {
+			t.Errorf("%s: got status %d after %d calls: %v", name, res.StatusCode, calls, err)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = filterFunction{}
+)
+
+var (
+	// filterOperators are the V3 standard filter operators which compare a
+	// property to a value.
+	filterOperators = []string{"eq", "ne", "gt", "ge", "lt", "le", "co", "sw", "in"}
+	// filterUnaryOperators take no value.
+	filterUnaryOperators  = []string{"pr", "isnull"}
+	filterPropertyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+)
+
+func NewFilterFunction() function.Function {
+	return filterFunction{}
+}
+
+// filterFunction builds a single V3 standard filter expression, quoting and
+// escaping its value.
+type filterFunction struct{}
+
+func (f filterFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "filter"
+}
+
+func (f filterFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a SailPoint filter expression",
+		MarkdownDescription: "Builds a V3 standard filter expression (ex. `name eq \"John\"`) for the `filters` argument of the data sources, " +
+			"quoting and escaping the value. Strings are quoted, numbers and booleans are not, and lists are only accepted by the `in` operator. " +
+			"Combine several expressions with `join(\" and \", [...])`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "property",
+				MarkdownDescription: "Property to filter on (ex. `name`, `source.id`).",
+			},
+			function.StringParameter{
+				Name:                "operator",
+				MarkdownDescription: fmt.Sprintf("Filter operator, one of %s.", strings.Join(slices.Concat(filterOperators, filterUnaryOperators), ", ")),
+			},
+			function.DynamicParameter{
+				Name:                "value",
+				AllowNullValue:      true,
+				MarkdownDescription: "Value compared to the property, a string, number, boolean or a list of them for `in`. Must be null for `pr` and `isnull`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f filterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var property, operator string
+	var value types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &property, &operator, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	expression, err := buildFilterExpression(property, operator, value.UnderlyingValue())
+	if err != nil {
+		resp.Error = err
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, expression))
+}
+
+// buildFilterExpression formats `property operator value`, value being nil or
+// null for unary operators.
+func buildFilterExpression(property, operator string, value attr.Value) (string, *function.FuncError) {
+	if !filterPropertyPattern.MatchString(property) {
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid filter property", property))
+	}
+
+	operator = strings.ToLower(operator)
+	isNull := value == nil || value.IsNull()
+
+	if slices.Contains(filterUnaryOperators, operator) {
+		if !isNull {
+			return "", function.NewArgumentFuncError(2, fmt.Sprintf("The %s operator takes no value, set it to null", operator))
+		}
+		return property + " " + operator, nil
+	}
+	if !slices.Contains(filterOperators, operator) {
+		return "", function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a filter operator, use one of %s", operator, strings.Join(slices.Concat(filterOperators, filterUnaryOperators), ", ")))
+	}
+	if isNull {
+		return "", function.NewArgumentFuncError(2, fmt.Sprintf("The %s operator requires a value", operator))
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case types.List:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	}
+
+	if operator != "in" {
+		if elements != nil {
+			return "", function.NewArgumentFuncError(2, fmt.Sprintf("The %s operator takes a single value, lists are only accepted by in", operator))
+		}
+		formatted, err := formatFilterValue(value)
+		if err != nil {
+			return "", function.NewArgumentFuncError(2, err.Error())
+		}
+		return fmt.Sprintf("%s %s %s", property, operator, formatted), nil
+	}
+
+	if elements == nil {
+		elements = []attr.Value{value}
+	}
+	if len(elements) == 0 {
+		return "", function.NewArgumentFuncError(2, "The in operator requires at least one value")
+	}
+	formatted := make([]string, 0, len(elements))
+	for _, element := range elements {
+		f, err := formatFilterValue(element)
+		if err != nil {
+			return "", function.NewArgumentFuncError(2, err.Error())
+		}
+		formatted = append(formatted, f)
+	}
+	return fmt.Sprintf("%s in (%s)", property, strings.Join(formatted, ",")), nil
+}
+
+// formatFilterValue formats a filter literal: strings are quoted with their
+// backslashes and quotes escaped, numbers and booleans are written as is.
+func formatFilterValue(value attr.Value) (string, error) {
+	if value.IsUnknown() || value.IsNull() {
+		return "", fmt.Errorf("filter values cannot be null")
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return quoteFilterString(v.ValueString()), nil
+	case types.Bool:
+		return fmt.Sprintf("%t", v.ValueBool()), nil
+	case types.Number:
+		return v.ValueBigFloat().Text('f', -1), nil
+	case types.Int64:
+		return fmt.Sprintf("%d", v.ValueInt64()), nil
+	case types.Float64:
+		return fmt.Sprintf("%g", v.ValueFloat64()), nil
+	}
+	return "", fmt.Errorf("filter values must be strings, numbers or booleans, got %s", value.Type(context.Background()))
+}
+
+func quoteFilterString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildFilterExpression(t *testing.T) {
+	tests := map[string]struct {
+		property string
+		operator string
+		value    attr.Value
+		want     string
+		wantErr  bool
+	}{
+		"string": {
+			property: "name", operator: "eq", value: types.StringValue(`John "JJ" \ Doe`),
+			want: `name eq "John \"JJ\" \\ Doe"`,
+		},
+		"bool": {
+			property: "enabled", operator: "EQ", value: types.BoolValue(true),
+			want: "enabled eq true",
+		},
+		"number": {
+			property: "count", operator: "gt", value: types.NumberValue(big.NewFloat(10)),
+			want: "count gt 10",
+		},
+		"in": {
+			property: "id", operator: "in",
+			value: types.TupleValueMust(
+				[]attr.Type{types.StringType, types.StringType},
+				[]attr.Value{types.StringValue("a"), types.StringValue("b")},
+			),
+			want: `id in ("a","b")`,
+		},
+		"unary": {
+			property: "manager.id", operator: "pr", value: types.DynamicNull(),
+			want: "manager.id pr",
+		},
+		"unary with value": {
+			property: "name", operator: "pr", value: types.StringValue("x"), wantErr: true,
+		},
+		"missing value": {
+			property: "name", operator: "eq", value: types.DynamicNull(), wantErr: true,
+		},
+		"list with eq": {
+			property: "id", operator: "eq",
+			value:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+			wantErr: true,
+		},
+		"invalid operator": {
+			property: "name", operator: "like", value: types.StringValue("x"), wantErr: true,
+		},
+		"invalid property": {
+			property: `name" or "1`, operator: "eq", value: types.StringValue("x"), wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := buildFilterExpression(test.property, test.operator, test.value)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFilterFunctionRun(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("name"),
+			types.StringValue("sw"),
+			types.DynamicValue(types.StringValue("Jo")),
+		}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	filterFunction{}.Run(context.Background(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if got := resp.Result.Value(); !got.Equal(types.StringValue(`name sw "Jo"`)) {
+		t.Errorf("unexpected result %s", got)
+	}
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = filterSyntaxValidator{}
+
+// filterSyntaxValidator rejects filters attributes which are not valid V3
+// standard filter expressions, so mistakes surface at plan time with their
+// position instead of as a 400 response at apply time. Whether a property
+// or operator is supported is left to the API, it differs per endpoint.
+type filterSyntaxValidator struct{}
+
+func (v filterSyntaxValidator) Description(_ context.Context) string {
+	return "value must be a valid V3 standard filter expression"
+}
+
+func (v filterSyntaxValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v filterSyntaxValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	if err := parseFilter(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Filter Expression",
+			fmt.Sprintf("%s. See https://developer.sailpoint.com/docs/standard-collection-parameters/#filtering-results for the filter syntax.", err),
+		)
+	}
+}
+
+// filterComparisonOperators compare a property to a single value, in and ca
+// to a parenthesized list of values.
+var (
+	filterComparisonOperators = []string{"eq", "ne", "gt", "ge", "lt", "le", "co", "sw", "ew"}
+	filterListOperators       = []string{"in", "ca"}
+)
+
+// filterSyntaxError locates a syntax error in a filter expression, Position
+// being the 1-based index of the offending character.
+type filterSyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *filterSyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at position %d: %s", e.Position, e.Message)
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEOF filterTokenKind = iota
+	filterTokenWord
+	filterTokenString
+	filterTokenOpen
+	filterTokenClose
+	filterTokenComma
+)
+
+type filterToken struct {
+	kind     filterTokenKind
+	text     string
+	position int
+}
+
+func (t filterToken) describe() string {
+	switch t.kind {
+	case filterTokenEOF:
+		return "end of filter"
+	case filterTokenString:
+		return "string " + t.text
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// tokenizeFilter splits a filter into words (properties, operators and
+// unquoted literals such as numbers, booleans and dates), quoted strings,
+// parentheses and commas.
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(filter)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{filterTokenOpen, "(", i + 1})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{filterTokenClose, ")", i + 1})
+			i++
+		case r == ',':
+			tokens = append(tokens, filterToken{filterTokenComma, ",", i + 1})
+			i++
+		case r == '"':
+			start := i
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(runes) {
+				return nil, &filterSyntaxError{start + 1, "unterminated string"}
+			}
+			i++
+			tokens = append(tokens, filterToken{filterTokenString, string(runes[start:i]), start + 1})
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.-+:", r):
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || strings.ContainsRune("_.-+:", runes[i])) {
+				i++
+			}
+			tokens = append(tokens, filterToken{filterTokenWord, string(runes[start:i]), start + 1})
+		default:
+			return nil, &filterSyntaxError{i + 1, fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+
+	return append(tokens, filterToken{filterTokenEOF, "", len(runes) + 1}), nil
+}
+
+// filterParser is a recursive descent parser of the grammar:
+//
+//	expression = term { "or" term }
+//	term       = factor { "and" factor }
+//	factor     = "not" factor | "(" expression ")" | comparison
+//	comparison = property ( "pr" | "isnull" | operator value | listop "(" value { "," value } ")" )
+type filterParser struct {
+	tokens []filterToken
+	next   int
+}
+
+// parseFilter checks the syntax of a V3 standard filter expression.
+func parseFilter(filter string) error {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	p := &filterParser{tokens: tokens}
+	if err := p.expression(); err != nil {
+		return err
+	}
+	if token := p.peek(); token.kind != filterTokenEOF {
+		return &filterSyntaxError{token.position, fmt.Sprintf("expected and, or or end of filter, got %s", token.describe())}
+	}
+	return nil
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.next]
+}
+
+func (p *filterParser) take() filterToken {
+	token := p.tokens[p.next]
+	if token.kind != filterTokenEOF {
+		p.next++
+	}
+	return token
+}
+
+func (p *filterParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == filterTokenWord && strings.EqualFold(token.text, keyword)
+}
+
+func (p *filterParser) expression() error {
+	if err := p.term(); err != nil {
+		return err
+	}
+	for p.isKeyword("or") {
+		p.take()
+		if err := p.term(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *filterParser) term() error {
+	if err := p.factor(); err != nil {
+		return err
+	}
+	for p.isKeyword("and") {
+		p.take()
+		if err := p.factor(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *filterParser) factor() error {
+	if p.isKeyword("not") {
+		p.take()
+		return p.factor()
+	}
+
+	if p.peek().kind == filterTokenOpen {
+		p.take()
+		if err := p.expression(); err != nil {
+			return err
+		}
+		if token := p.take(); token.kind != filterTokenClose {
+			return &filterSyntaxError{token.position, fmt.Sprintf("expected ), got %s", token.describe())}
+		}
+		return nil
+	}
+
+	return p.comparison()
+}
+
+func (p *filterParser) comparison() error {
+	property := p.take()
+	if property.kind != filterTokenWord || !filterPropertyPattern.MatchString(property.text) {
+		return &filterSyntaxError{property.position, fmt.Sprintf("expected a property, got %s", property.describe())}
+	}
+
+	operator := p.take()
+	name := strings.ToLower(operator.text)
+	switch {
+	case operator.kind == filterTokenWord && slices.Contains(filterUnaryOperators, name):
+		return nil
+	case operator.kind == filterTokenWord && slices.Contains(filterComparisonOperators, name):
+		return p.value()
+	case operator.kind == filterTokenWord && slices.Contains(filterListOperators, name):
+		if token := p.take(); token.kind != filterTokenOpen {
+			return &filterSyntaxError{token.position, fmt.Sprintf("expected ( after %s, got %s", name, token.describe())}
+		}
+		for {
+			if err := p.value(); err != nil {
+				return err
+			}
+			token := p.take()
+			if token.kind == filterTokenClose {
+				return nil
+			}
+			if token.kind != filterTokenComma {
+				return &filterSyntaxError{token.position, fmt.Sprintf("expected , or ), got %s", token.describe())}
+			}
+		}
+	}
+
+	operators := slices.Concat(filterComparisonOperators, filterListOperators, filterUnaryOperators)
+	return &filterSyntaxError{operator.position, fmt.Sprintf("expected an operator (%s) after %s, got %s", strings.Join(operators, ", "), property.text, operator.describe())}
+}
+
+func (p *filterParser) value() error {
+	token := p.take()
+	if token.kind == filterTokenString {
+		return nil
+	}
+	// Unquoted values are numbers, booleans, null and dates, anything else is
+	// most likely a string missing its quotes.
+	if token.kind == filterTokenWord {
+		first := []rune(token.text)[0]
+		if unicode.IsDigit(first) || first == '-' || first == '+' || slices.Contains([]string{"true", "false", "null"}, strings.ToLower(token.text)) {
+			return nil
+		}
+		return &filterSyntaxError{token.position, fmt.Sprintf("expected a value, got %s (strings must be quoted)", token.describe())}
+	}
+	return &filterSyntaxError{token.position, fmt.Sprintf("expected a value, got %s", token.describe())}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	valid := []string{
+		`name eq "John"`,
+		`name sw "J" and (enabled eq true or type in ("A","B"))`,
+		`not manager.id pr`,
+		`created gt 2024-01-01T00:00:00Z and count ge -1.5`,
+		`name eq "quote \" inside"`,
+		`accessProfileIds ca ("a", "b")`,
+		`attributes.cloudStatus isnull`,
+	}
+	for _, filter := range valid {
+		if err := parseFilter(filter); err != nil {
+			t.Errorf("unexpected error for %s: %s", filter, err)
+		}
+	}
+
+	invalid := map[string]string{
+		`name eq John`:         `syntax error at position 9: expected a value, got "John" (strings must be quoted)`,
+		`name equals "John"`:   `syntax error at position 6: expected an operator (eq, ne, gt, ge, lt, le, co, sw, ew, in, ca, pr, isnull) after name, got "equals"`,
+		`name eq "John`:        `syntax error at position 9: unterminated string`,
+		`(name eq "a"`:         `syntax error at position 13: expected ), got end of filter`,
+		`name eq "a" "b"`:      `syntax error at position 13: expected and, or or end of filter, got string "b"`,
+		`id in ("a" "b")`:      `syntax error at position 12: expected , or ), got string "b"`,
+		`name eq "a" and`:      `syntax error at position 16: expected a property, got end of filter`,
+		`name eq "a" && id pr`: `syntax error at position 13: unexpected character '&'`,
+	}
+	for filter, want := range invalid {
+		err := parseFilter(filter)
+		if err == nil {
+			t.Errorf("expected an error for %s", filter)
+			continue
+		}
+		if err.Error() != want {
+			t.Errorf("%s: got %q, want %q", filter, err, want)
+		}
+	}
+}
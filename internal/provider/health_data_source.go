@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &healthDataSource{}
+	_ datasource.DataSourceWithConfigure = &healthDataSource{}
+)
+
+// healthOrgProduct is the product of the tenant whose status is the status of
+// the org.
+const healthOrgProduct = "idn"
+
+func NewHealthDataSource() datasource.DataSource {
+	return &healthDataSource{}
+}
+
+type healthDataSource struct {
+	data *providerData
+}
+
+type healthDataSourceModel struct {
+	APIVersion   types.String         `tfsdk:"api_version"`
+	Healthy      types.Bool           `tfsdk:"healthy"`
+	APIReachable types.Bool           `tfsdk:"api_reachable"`
+	TokenValid   types.Bool           `tfsdk:"token_valid"`
+	OrgStatus    types.String         `tfsdk:"org_status"`
+	TenantName   types.String         `tfsdk:"tenant_name"`
+	Pod          types.String         `tfsdk:"pod"`
+	Region       types.String         `tfsdk:"region"`
+	Products     []healthProductModel `tfsdk:"products"`
+	Errors       []types.String       `tfsdk:"errors"`
+}
+
+type healthProductModel struct {
+	Name    types.String `tfsdk:"name"`
+	Status  types.String `tfsdk:"status"`
+	OrgType types.String `tfsdk:"org_type"`
+	Reason  types.String `tfsdk:"reason"`
+}
+
+func (d *healthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+func (d *healthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Health of the tenant the provider is configured for: whether its API is reachable, the access token accepted, and the org active. Failed checks are reported in the attributes instead of failing the read, for use in check blocks.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"healthy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether every check passed: the API is reachable, the token is valid, and the org is active.",
+			},
+			"api_reachable": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the tenant API responded.",
+			},
+			"token_valid": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the tenant API accepted the access token. Null when the API is not reachable.",
+			},
+			"org_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the Identity Security Cloud product of the tenant, such as active. Null when the tenant could not be read or does not report it.",
+			},
+			"tenant_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the tenant.",
+			},
+			"pod": schema.StringAttribute{
+				Computed:    true,
+				Description: "Pod hosting the tenant.",
+			},
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Description: "Region of the tenant.",
+			},
+			"products": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Products of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the product.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the product.",
+						},
+						"org_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the org, such as production or sandbox.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the provisioning failure of the product, if any.",
+						},
+					},
+				},
+			},
+			"errors": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Description of each failed check.",
+			},
+		},
+	}
+}
+
+func (d *healthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Health data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *healthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Health")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state healthDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenant, res, err := client.V2025.TenantAPI.GetTenant(ctx).Execute()
+	serializeHealth(ctx, &state, tenant, res, err)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// serializeHealth sets the checks of state from the result of the read of the
+// tenant.
+func serializeHealth(ctx context.Context, state *healthDataSourceModel, tenant *v2025.Tenant, res *http.Response, err error) {
+	state.APIReachable = types.BoolValue(res != nil)
+	state.TokenValid = types.BoolNull()
+	state.OrgStatus = types.StringNull()
+	state.TenantName = types.StringNull()
+	state.Pod = types.StringNull()
+	state.Region = types.StringNull()
+	state.Products = []healthProductModel{}
+	state.Errors = []types.String{}
+
+	if res != nil {
+		state.TokenValid = types.BoolValue(res.StatusCode != http.StatusUnauthorized)
+	}
+	if err != nil {
+		state.Healthy = types.BoolValue(false)
+		state.Errors = append(state.Errors, types.StringValue(describeAPIError(ctx, "Unable to Read Tenant", err, res)))
+		return
+	}
+
+	state.TenantName = types.StringPointerValue(tenant.Name)
+	state.Pod = types.StringPointerValue(tenant.Pod)
+	state.Region = types.StringPointerValue(tenant.Region)
+	for _, product := range tenant.Products {
+		state.Products = append(state.Products, healthProductModel{
+			Name:    types.StringPointerValue(product.ProductName),
+			Status:  types.StringPointerValue(product.Status),
+			OrgType: types.StringPointerValue(product.OrgType.Get()),
+			Reason:  types.StringPointerValue(product.Reason),
+		})
+		if product.GetProductName() == healthOrgProduct {
+			state.OrgStatus = types.StringPointerValue(product.Status)
+		}
+	}
+
+	if !state.OrgStatus.IsNull() && !strings.EqualFold(state.OrgStatus.ValueString(), "active") {
+		state.Errors = append(state.Errors, types.StringValue(fmt.Sprintf("The org status is %s.", state.OrgStatus.ValueString())))
+	}
+	state.Healthy = types.BoolValue(len(state.Errors) == 0)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestSerializeHealth(t *testing.T) {
+	var tenant v2025.Tenant
+	body := `{"name": "acme", "pod": "stg01", "region": "us-east-1", "products": [{"productName": "idn", "status": "suspended"}]}`
+	if err := json.Unmarshal([]byte(body), &tenant); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		tenant                        *v2025.Tenant
+		res                           *http.Response
+		err                           error
+		healthy, reachable, tokenNull bool
+		orgStatus                     string
+	}{
+		"unreachable":   {err: errors.New("dial tcp: no such host"), tokenNull: true},
+		"unauthorized":  {res: &http.Response{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized"}, err: errors.New("401 Unauthorized"), reachable: true},
+		"suspended org": {tenant: &tenant, res: &http.Response{StatusCode: http.StatusOK}, reachable: true, orgStatus: "suspended"},
+	}
+
+	for name, test := range tests {
+		var state healthDataSourceModel
+		serializeHealth(context.Background(), &state, test.tenant, test.res, test.err)
+
+		if state.Healthy.ValueBool() != test.healthy || state.APIReachable.ValueBool() != test.reachable {
+			t.Errorf("%s: got healthy %s and api_reachable %s", name, state.Healthy, state.APIReachable)
+		}
+		if state.TokenValid.IsNull() != test.tokenNull {
+			t.Errorf("%s: got token_valid %s", name, state.TokenValid)
+		}
+		if state.OrgStatus.ValueString() != test.orgStatus {
+			t.Errorf("%s: got org_status %s", name, state.OrgStatus)
+		}
+		if len(state.Errors) == 0 {
+			t.Errorf("%s: expected errors", name)
+		}
+	}
+}
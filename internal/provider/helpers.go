@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+// sailPointTimeValue converts an optional API timestamp into a timestamp
+// value, returning null when the API omitted it.
+func sailPointTimeValue(t *api_v2025.SailPointTime) rfc3339Value {
+	if t == nil || t.IsZero() {
+		return rfc3339Null()
+	}
+	return rfc3339TimeValue(t.Time)
+}
+
+var typedReferenceAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+}
+
+// typedReferenceModel maps the {type, id} reference objects the API uses to
+// point at owners, approvers and other related objects.
+type typedReferenceModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+var namedReferenceAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"id":   types.StringType,
+	"name": types.StringType,
+}
+
+// namedReferenceModel maps the {type, id, name} reference objects returned by
+// the newer APIs.
+type namedReferenceModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func serializeBaseReference(ctx context.Context, ref *api_v2025.BaseReferenceDto) (types.Object, diag.Diagnostics) {
+	if ref == nil {
+		return types.ObjectNull(namedReferenceAttrTypes), nil
+	}
+
+	var refType *string
+	if ref.Type != nil {
+		t := string(*ref.Type)
+		refType = &t
+	}
+
+	return types.ObjectValueFrom(ctx, namedReferenceAttrTypes, namedReferenceModel{
+		Type: types.StringPointerValue(refType),
+		ID:   types.StringPointerValue(ref.Id),
+		Name: types.StringPointerValue(ref.Name),
+	})
+}
+
+// serializeReferenceMap converts the loosely typed reference objects some
+// endpoints return as plain JSON maps into a named reference.
+func serializeReferenceMap(ctx context.Context, ref map[string]interface{}) (types.Object, diag.Diagnostics) {
+	if ref == nil {
+		return types.ObjectNull(namedReferenceAttrTypes), nil
+	}
+
+	value := func(key string) types.String {
+		if s, ok := ref[key].(string); ok {
+			return types.StringValue(s)
+		}
+		return types.StringNull()
+	}
+
+	return types.ObjectValueFrom(ctx, namedReferenceAttrTypes, namedReferenceModel{
+		Type: value("type"),
+		ID:   value("id"),
+		Name: value("name"),
+	})
+}
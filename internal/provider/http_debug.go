@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// redacted replaces secrets in logged headers and bodies.
+	redacted = "REDACTED"
+	// maxLoggedBodySize bounds the bodies logged by debugTransport, exports
+	// and search results can be several megabytes.
+	maxLoggedBodySize = 64 * 1024
+)
+
+var (
+	// sensitiveHeaders are never logged as is.
+	sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+	// loggedResponseHeaders are the response headers worth logging, mostly to
+	// understand how close a run is to the tenant rate limits.
+	loggedResponseHeaders = []string{"Retry-After", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "X-Total-Count", "Slpt-Request-Id"}
+	// sensitiveFieldPattern matches the JSON and form fields redacted from
+	// logged bodies.
+	sensitiveFieldPattern = regexp.MustCompile(`(?i)(password|secret|token|credential|private_?key|api_?key|alert_?key|authorization)`)
+)
+
+// debugTransport logs every request and response at debug level, with
+// secrets redacted, when the provider's debug_http setting is enabled.
+type debugTransport struct {
+	base http.RoundTripper
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	requestFields := map[string]any{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header, nil),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		requestFields["body"] = redactBody(body, req.Header.Get("Content-Type"))
+	}
+	tflog.Debug(ctx, "SailPoint API request", requestFields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		tflog.Debug(ctx, "SailPoint API request failed", map[string]any{
+			"method":   req.Method,
+			"url":      req.URL.String(),
+			"duration": time.Since(start).String(),
+			"error":    err.Error(),
+		})
+		return resp, err
+	}
+
+	responseFields := map[string]any{
+		"method":   req.Method,
+		"url":      req.URL.String(),
+		"status":   resp.StatusCode,
+		"duration": time.Since(start).String(),
+		"headers":  redactHeaders(resp.Header, loggedResponseHeaders),
+	}
+	if resp.Body != nil {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		responseFields["body"] = redactBody(body, resp.Header.Get("Content-Type"))
+	}
+	tflog.Debug(ctx, "SailPoint API response", responseFields)
+
+	return resp, nil
+}
+
+// redactHeaders flattens header for logging, replacing sensitive values. Only
+// the headers listed in only are kept when it is not nil.
+func redactHeaders(header http.Header, only []string) map[string]string {
+	flat := make(map[string]string, len(header))
+	for name, values := range header {
+		if only != nil && !containsHeader(only, name) {
+			continue
+		}
+		if containsHeader(sensitiveHeaders, name) {
+			flat[name] = redacted
+			continue
+		}
+		flat[name] = strings.Join(values, ", ")
+	}
+	return flat
+}
+
+func containsHeader(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns body as a string with the values of sensitive JSON and
+// form fields redacted. Bodies of other content types are only truncated.
+func redactBody(body []byte, contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for key := range values {
+				if sensitiveFieldPattern.MatchString(key) {
+					values[key] = []string{redacted}
+				}
+			}
+			return truncateBody(values.Encode())
+		}
+	case strings.Contains(mediaType, "json"):
+		var value any
+		if err := json.Unmarshal(body, &value); err == nil {
+			if redactedBody, err := json.Marshal(redactJSON(value)); err == nil {
+				return truncateBody(string(redactedBody))
+			}
+		}
+	}
+
+	return truncateBody(string(body))
+}
+
+// redactJSON replaces the values of sensitive keys of a decoded JSON value,
+// recursively.
+func redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if sensitiveFieldPattern.MatchString(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(field)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactJSON(v[i])
+		}
+	}
+	return value
+}
+
+func truncateBody(body string) string {
+	if len(body) <= maxLoggedBodySize {
+		return body
+	}
+	return body[:maxLoggedBodySize] + "... (truncated)"
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer secret")
+	header.Set("Content-Type", "application/json")
+	header.Set("X-RateLimit-Remaining", "10")
+
+	got := redactHeaders(header, nil)
+	if got["Authorization"] != redacted {
+		t.Errorf("Authorization was not redacted: %q", got["Authorization"])
+	}
+	if got["Content-Type"] != "application/json" {
+		t.Errorf("unexpected Content-Type %q", got["Content-Type"])
+	}
+
+	got = redactHeaders(header, loggedResponseHeaders)
+	if len(got) != 1 || got["X-Ratelimit-Remaining"] != "10" {
+		t.Errorf("unexpected filtered headers %v", got)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := map[string]struct {
+		body        string
+		contentType string
+		want        string
+	}{
+		"json": {
+			body:        `{"name":"cluster","configuration":{"alertKey":"k","debug":"false"},"items":[{"password":"p"}]}`,
+			contentType: "application/json; charset=utf-8",
+			want:        `{"configuration":{"alertKey":"REDACTED","debug":"false"},"items":[{"password":"REDACTED"}],"name":"cluster"}`,
+		},
+		"token response": {
+			body:        `{"access_token":"abc","expires_in":43199}`,
+			contentType: "application/json",
+			want:        `{"access_token":"REDACTED","expires_in":43199}`,
+		},
+		"form": {
+			body:        "client_id=id&client_secret=secret&grant_type=client_credentials",
+			contentType: "application/x-www-form-urlencoded",
+			want:        "client_id=id&client_secret=REDACTED&grant_type=client_credentials",
+		},
+		"text": {
+			body:        "bad gateway",
+			contentType: "text/plain",
+			want:        "bad gateway",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody([]byte(test.body), test.contentType); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDebugTransportPreservesBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &debugTransport{base: http.DefaultTransport}}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"x"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"name":"x"}` {
+		t.Errorf("unexpected body %q", body)
+	}
+}
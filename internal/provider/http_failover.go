@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// tenantBaseURLFormat derives the API base URL of a tenant from its name.
+const tenantBaseURLFormat = "https://%s.api.identitynow.com"
+
+// failoverBaseURLs parses the base URL of the tenant followed by its
+// failover base URLs, skipping duplicates.
+func failoverBaseURLs(baseURL string, failover []string) ([]*url.URL, error) {
+	baseURLs := make([]*url.URL, 0, len(failover)+1)
+	seen := make(map[string]bool, len(failover)+1)
+	for _, value := range append([]string{baseURL}, failover...) {
+		value = strings.TrimSuffix(value, "/")
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+
+		parsed, err := url.Parse(value)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return nil, fmt.Errorf("the base URL %q must be an absolute URL (ex. https://tenant.api.identitynow.com)", value)
+		}
+		baseURLs = append(baseURLs, parsed)
+	}
+	return baseURLs, nil
+}
+
+// failoverTransport sends requests to the next base URL of the tenant when
+// the current one can't be reached, for tenants with regional API gateways.
+// Only requests which couldn't connect fail over, so no request is sent
+// twice. Requests keep going to the last base URL which answered.
+type failoverTransport struct {
+	base http.RoundTripper
+	// baseURLs are the base URL the SDK is configured with, followed by the
+	// failover ones.
+	baseURLs []*url.URL
+	current  atomic.Int32
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.targets(req.URL) {
+		return t.base.RoundTrip(req)
+	}
+
+	// The body has to be replayed on every base URL.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := int(t.current.Load())
+	var err error
+	for i := range t.baseURLs {
+		index := (start + i) % len(t.baseURLs)
+
+		attemptReq := req.Clone(req.Context())
+		attemptReq.URL = t.rewrite(req.URL, t.baseURLs[index])
+		attemptReq.Host = ""
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		var resp *http.Response
+		resp, err = t.base.RoundTrip(attemptReq)
+		if err == nil {
+			if index != start {
+				tflog.Warn(req.Context(), "SailPoint API base URL unreachable, using a failover base URL", map[string]any{"base_url": t.baseURLs[index].String()})
+				t.current.Store(int32(index))
+			}
+			return resp, nil
+		}
+		if req.Context().Err() != nil || !unreachable(err) {
+			return nil, err
+		}
+
+		tflog.Debug(req.Context(), "Unable to reach SailPoint API base URL", map[string]any{"base_url": t.baseURLs[index].String(), "error": err.Error()})
+	}
+	return nil, err
+}
+
+// targets reports whether the URL is under the base URL the SDK is
+// configured with.
+func (t *failoverTransport) targets(u *url.URL) bool {
+	primary := t.baseURLs[0]
+	return u.Scheme == primary.Scheme && u.Host == primary.Host && strings.HasPrefix(u.Path, primary.Path)
+}
+
+// rewrite moves the URL from the base URL the SDK is configured with to
+// baseURL.
+func (t *failoverTransport) rewrite(u *url.URL, baseURL *url.URL) *url.URL {
+	rewritten := *u
+	rewritten.Scheme = baseURL.Scheme
+	rewritten.Host = baseURL.Host
+	rewritten.Path = baseURL.Path + strings.TrimPrefix(u.Path, t.baseURLs[0].Path)
+	rewritten.RawPath = ""
+	return &rewritten
+}
+
+// unreachable reports whether the request failed before being sent, as when
+// the host can't be resolved or refuses the connection.
+func unreachable(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
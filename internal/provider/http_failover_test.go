@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFailoverTransport(t *testing.T) {
+	// A closed listener gives an address refusing connections.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unreachableURL := "http://" + listener.Addr().String() + "/gateway"
+	listener.Close()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path != "/v2025/sources" || string(body) != "payload" {
+			t.Errorf("unexpected request %s %q", r.URL.Path, body)
+		}
+	}))
+	defer server.Close()
+
+	baseURLs, err := failoverBaseURLs(unreachableURL, []string{unreachableURL, server.URL + "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(baseURLs) != 2 {
+		t.Fatalf("expected duplicates to be skipped, got %v", baseURLs)
+	}
+
+	transport := &failoverTransport{base: http.DefaultTransport, baseURLs: baseURLs}
+	client := &http.Client{Transport: transport}
+	for range 2 {
+		resp, err := client.Post(unreachableURL+"/v2025/sources", "text/plain", strings.NewReader("payload"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+	if transport.current.Load() != 1 {
+		t.Errorf("expected the failover base URL to be kept, got %d", transport.current.Load())
+	}
+}
+
+func TestFailoverBaseURLsInvalid(t *testing.T) {
+	if _, err := failoverBaseURLs("https://acme.api.identitynow.com", []string{"acme.api.identitynow.com"}); err == nil {
+		t.Error("expected an error for a relative base URL")
+	}
+}
@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultRateLimitMaxRetries     = 5
+	defaultRateLimitMaxElapsedTime = 5 * time.Minute
+	defaultRetryMaxAttempts        = 3
+	defaultRequestTimeout          = 10 * time.Minute
+	defaultTokenTimeout            = 30 * time.Second
+
+	retryWaitMin = 1 * time.Second
+	retryWaitMax = 30 * time.Second
+)
+
+// retrySettings controls how requests rejected by the API are retried.
+type retrySettings struct {
+	// RateLimitMaxRetries is how many times a request answered with
+	// 429 Too Many Requests is retried.
+	RateLimitMaxRetries int
+	// RateLimitMaxElapsedTime caps the total time spent retrying a single
+	// rate limited request, including the waits.
+	RateLimitMaxElapsedTime time.Duration
+	// MaxAttempts is how many times a request is sent in total when it fails
+	// with a transient error (connection failures, timeouts and any of
+	// RetryableStatusCodes). Requests which aren't idempotent, such as
+	// creations, are only retried when they couldn't be sent.
+	MaxAttempts int
+	// RetryableStatusCodes are the server error codes considered transient.
+	RetryableStatusCodes []int
+	// RequestTimeout bounds every single attempt, zero means no timeout.
+	RequestTimeout time.Duration
+}
+
+// defaultRetryableStatusCodes are the gateway errors returned while the API
+// is briefly unavailable.
+func defaultRetryableStatusCodes() []int {
+	return []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+}
+
+// retryTransport retries requests the API rejected because of rate limiting
+// or transient failures, honoring the Retry-After header and backing off
+// exponentially otherwise.
+type retryTransport struct {
+	base     http.RoundTripper
+	settings retrySettings
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The body has to be replayed on every attempt.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	rateLimitRetries, transientRetries := 0, 0
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(req, body)
+		if req.Context().Err() != nil {
+			return resp, err
+		}
+
+		var reason string
+		switch {
+		case err == nil && resp.StatusCode == http.StatusTooManyRequests:
+			if rateLimitRetries >= t.settings.RateLimitMaxRetries {
+				return resp, err
+			}
+			rateLimitRetries++
+			reason = "rate limit reached"
+		case t.transient(req, resp, err):
+			if transientRetries+1 >= t.settings.MaxAttempts {
+				return resp, err
+			}
+			transientRetries++
+			reason = "transient error"
+		default:
+			return resp, err
+		}
+
+		wait := retryBackoff(attempt, resp)
+		if reason == "rate limit reached" && time.Since(start)+wait > t.settings.RateLimitMaxElapsedTime {
+			return resp, err
+		}
+
+		fields := map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status_code"] = resp.StatusCode
+
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(req.Context(), "SailPoint API "+reason+", retrying request", fields)
+
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends a copy of the request, bounded by the per-request timeout.
+func (t *retryTransport) attempt(req *http.Request, body []byte) (*http.Response, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.settings.RequestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.settings.RequestTimeout)
+	}
+
+	attemptReq := req.Clone(ctx)
+	if body != nil {
+		attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.base.RoundTrip(attemptReq)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The timeout also covers reading the body, so it is only released
+	// once the caller is done with the response.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// transient reports whether a failed attempt is worth retrying. A request
+// which isn't idempotent may have been processed when its attempt timed out
+// or a gateway error was returned, replaying it could then create duplicates,
+// so it is only retried when it never reached the server.
+func (t *retryTransport) transient(req *http.Request, resp *http.Response, err error) bool {
+	if !idempotent(req.Method) {
+		return err != nil && unreachable(err)
+	}
+	if err != nil {
+		return true
+	}
+	return slices.Contains(t.settings.RetryableStatusCodes, resp.StatusCode)
+}
+
+// idempotent reports whether sending a request of the method twice has the
+// same effect as sending it once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// retryBackoff returns how long to wait before retrying, using the
+// Retry-After header when the API sent one.
+func retryBackoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if date, err := http.ParseTime(resp.Header.Get("Retry-After")); err == nil {
+			return max(time.Until(date), 0)
+		}
+	}
+
+	wait := retryWaitMin << attempt
+	if wait <= 0 || wait > retryWaitMax {
+		wait = retryWaitMax
+	}
+	return wait
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// concurrencyTransport bounds the number of requests in flight at once, so
+// the parallel operations Terraform runs stay under the tenant rate limits.
+type concurrencyTransport struct {
+	base  http.RoundTripper
+	slots chan struct{}
+}
+
+func newConcurrencyTransport(base http.RoundTripper, maxConcurrentRequests int) *concurrencyTransport {
+	return &concurrencyTransport{
+		base:  base,
+		slots: make(chan struct{}, maxConcurrentRequests),
+	}
+}
+
+func (t *concurrencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.slots }()
+
+	return t.base.RoundTrip(req)
+}
+
+// correlationIDHeader carries the ID tying requests to the Terraform run
+// which sent them.
+const correlationIDHeader = "X-Correlation-Id"
+
+// headerTransport adds the headers identifying the provider to every request.
+type headerTransport struct {
+	base           http.RoundTripper
+	userAgentExtra string
+	correlationID  string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+
+	if t.userAgentExtra != "" {
+		userAgent := req.Header.Get("User-Agent")
+		if userAgent != "" {
+			userAgent += " "
+		}
+		req.Header.Set("User-Agent", userAgent+t.userAgentExtra)
+	}
+
+	if t.correlationID != "" {
+		req.Header.Set(correlationIDHeader, t.correlationID)
+	}
+
+	tflog.Trace(req.Context(), "Sending SailPoint API request", map[string]any{
+		"method":         req.Method,
+		"url":            req.URL.String(),
+		"correlation_id": t.correlationID,
+	})
+
+	return t.base.RoundTrip(req)
+}
+
+// authTransport sets the access token of every request, replacing the one
+// the SDK adds, so tokens are refreshed as they expire. A request rejected
+// with 401 Unauthorized is sent once more with a new token, in case the
+// token was revoked before its expiry.
+type authTransport struct {
+	base   http.RoundTripper
+	tokens tokenSource
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokens.token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("requesting access token: %w", err)
+	}
+
+	resp, err := t.base.RoundTrip(authenticate(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	// The body was consumed by the first attempt.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+	if !t.tokens.invalidate(req.Context(), token) {
+		return resp, nil
+	}
+
+	token, err = t.tokens.token(req.Context())
+	if err != nil {
+		// The rejection is more telling than the failure to replace the
+		// token.
+		tflog.Warn(req.Context(), "Unable to refresh rejected SailPoint access token", map[string]any{"error": err.Error()})
+		return resp, nil
+	}
+	retry := authenticate(req, token)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return resp, nil
+		}
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return t.base.RoundTrip(retry)
+}
+
+// authenticate returns a copy of the request carrying the token.
+func authenticate(req *http.Request, token *oauth2.Token) *http.Request {
+	req = req.Clone(req.Context())
+	token.SetAuthHeader(req)
+	return req
+}
+
+// authenticatedHTTPClient returns a client sending the requests of client
+// with the tokens of the source. The client requesting the tokens must not be
+// authenticated itself, so the SDK is handed this one instead.
+func authenticatedHTTPClient(client *retryablehttp.Client, tokens tokenSource) *retryablehttp.Client {
+	authenticated := retryablehttp.NewClient()
+	authenticated.Logger = nil
+	authenticated.RetryMax = client.RetryMax
+	authenticated.HTTPClient.Timeout = client.HTTPClient.Timeout
+	authenticated.HTTPClient.Transport = &authTransport{base: client.HTTPClient.Transport, tokens: tokens}
+	return authenticated
+}
+
+// newCorrelationID returns a random ID for the requests of a Terraform run.
+func newCorrelationID() string {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// tlsVersions maps the accepted tls_min_version values to their constants.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// connectionSettings controls how connections to the tenant are opened.
+type connectionSettings struct {
+	// ProxyURL overrides the proxy from the HTTP(S)_PROXY environment
+	// variables when set.
+	ProxyURL *url.URL
+	// CABundle holds PEM encoded certificates trusted on top of the system
+	// ones, for proxies intercepting TLS.
+	CABundle []byte
+	// TLSMinVersion is the minimum TLS version accepted, zero keeps the Go
+	// default.
+	TLSMinVersion uint16
+}
+
+// configure applies the connection settings to the transport.
+func (s connectionSettings) configure(transport *http.Transport) error {
+	if s.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(s.ProxyURL)
+	}
+
+	if len(s.CABundle) == 0 && s.TLSMinVersion == 0 {
+		return nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: s.TLSMinVersion}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+		tlsConfig.MinVersion = s.TLSMinVersion
+	}
+
+	if len(s.CABundle) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(s.CABundle) {
+			return errors.New("no PEM encoded certificate found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return nil
+}
+
+// httpClientSettings groups the settings of the HTTP client shared by every
+// SDK API version.
+type httpClientSettings struct {
+	Retry      retrySettings
+	Connection connectionSettings
+	// MaxConcurrentRequests bounds the requests in flight, zero means
+	// unlimited.
+	MaxConcurrentRequests int
+	// RequestTimeout bounds an API call including its retries, zero means
+	// no timeout.
+	RequestTimeout time.Duration
+	// UserAgentExtra is appended to the User-Agent of every request.
+	UserAgentExtra string
+	// CorrelationID is sent with every request.
+	CorrelationID string
+	// DebugHTTP logs every request and response with secrets redacted.
+	DebugHTTP bool
+	// RateLimitUsage records the rate limit headers of every response.
+	RateLimitUsage *rateLimitUsage
+	// BaseURLs are the base URL of the tenant followed by the ones requests
+	// fail over to when it can't be reached.
+	BaseURLs []*url.URL
+}
+
+// newHTTPClient builds the HTTP client shared by every SDK API version.
+// Retries are handled by retryTransport, so the SDK's own retries are
+// disabled to avoid multiplying attempts. The concurrency limit applies to
+// every attempt instead of every request, so requests waiting to be retried
+// don't hold a slot. Debug logging also wraps every attempt, so retried
+// requests show up once per attempt, and failed over requests once per base
+// URL.
+func newHTTPClient(settings httpClientSettings) (*retryablehttp.Client, error) {
+	client := retryablehttp.NewClient()
+	// The default logger prints every request to stderr, requests are logged
+	// with tflog by the debug transport instead.
+	client.Logger = nil
+	client.RetryMax = 0
+	client.HTTPClient.Timeout = settings.RequestTimeout
+
+	var transport http.RoundTripper = cleanhttp.DefaultPooledTransport()
+	if err := settings.Connection.configure(transport.(*http.Transport)); err != nil {
+		return nil, err
+	}
+	if settings.MaxConcurrentRequests > 0 {
+		transport = newConcurrencyTransport(transport, settings.MaxConcurrentRequests)
+	}
+	if settings.RateLimitUsage != nil {
+		transport = &rateLimitTransport{base: transport, usage: settings.RateLimitUsage}
+	}
+	if settings.DebugHTTP {
+		transport = &debugTransport{base: transport}
+	}
+	if len(settings.BaseURLs) > 1 {
+		transport = &failoverTransport{base: transport, baseURLs: settings.BaseURLs}
+	}
+	client.HTTPClient.Transport = &headerTransport{
+		base: &retryTransport{
+			base:     transport,
+			settings: settings.Retry,
+		},
+		userAgentExtra: settings.UserAgentExtra,
+		correlationID:  settings.CorrelationID,
+	}
+	return client, nil
+}
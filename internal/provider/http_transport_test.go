@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func TestRetryTransportRateLimit(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("attempt %d: unexpected body %q", attempts, body)
+		}
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		base:     http.DefaultTransport,
+		settings: retrySettings{RateLimitMaxRetries: 5, RateLimitMaxElapsedTime: time.Minute},
+	}}
+
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryTransportRateLimitMaxRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		base:     http.DefaultTransport,
+		settings: retrySettings{RateLimitMaxRetries: 2, RateLimitMaxElapsedTime: time.Minute},
+	}}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	withHeader := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if got := retryBackoff(0, withHeader); got != 7*time.Second {
+		t.Errorf("expected Retry-After to be honored, got %s", got)
+	}
+
+	cases := map[int]time.Duration{
+		0:  1 * time.Second,
+		1:  2 * time.Second,
+		3:  8 * time.Second,
+		10: retryWaitMax,
+		70: retryWaitMax,
+	}
+	for attempt, expected := range cases {
+		if got := retryBackoff(attempt, &http.Response{Header: http.Header{}}); got != expected {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, expected, got)
+		}
+	}
+}
+
+func TestRetryTransportTransientErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		base: http.DefaultTransport,
+		settings: retrySettings{
+			MaxAttempts:          3,
+			RetryableStatusCodes: defaultRetryableStatusCodes(),
+		},
+	}}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	// 500 is not retryable by default, so the second attempt is returned.
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryTransportTransientErrorsNotIdempotent(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusGatewayTimeout)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		base: http.DefaultTransport,
+		settings: retrySettings{
+			MaxAttempts:          3,
+			RetryableStatusCodes: defaultRetryableStatusCodes(),
+		},
+	}}
+
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	// The POST may have been processed behind the gateway, so it isn't
+	// replayed.
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+
+	// A POST which never reached the server is retried.
+	dials := 0
+	client.Transport.(*retryTransport).base = dialFailingTransport(func() { dials++ })
+	if _, err := client.Post(server.URL, "text/plain", strings.NewReader("payload")); err == nil {
+		t.Fatal("expected an error")
+	}
+	if dials != 3 {
+		t.Errorf("expected 3 dial attempts, got %d", dials)
+	}
+}
+
+// dialFailingTransport fails every request as if the connection was refused,
+// calling itself first so attempts can be counted.
+type dialFailingTransport func()
+
+func (f dialFailingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	f()
+	return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+}
+
+func TestRetryTransportRequestTimeout(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		base: http.DefaultTransport,
+		settings: retrySettings{
+			MaxAttempts:    2,
+			RequestTimeout: 50 * time.Millisecond,
+		},
+	}}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestConcurrencyTransport(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newConcurrencyTransport(http.DefaultTransport, 2)}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", peak)
+	}
+}
+
+func TestConnectionSettingsConfigure(t *testing.T) {
+	transport := &http.Transport{}
+	settings := connectionSettings{TLSMinVersion: tls.VersionTLS13}
+	if err := settings.configure(transport); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if transport.TLSClientConfig == nil || transport.TLSClientConfig.MinVersion != tls.VersionTLS13 {
+		t.Errorf("expected TLS 1.3 minimum version, got %+v", transport.TLSClientConfig)
+	}
+
+	settings = connectionSettings{CABundle: []byte("not a certificate")}
+	if err := settings.configure(&http.Transport{}); err == nil {
+		t.Error("expected an error for a CA bundle without certificates")
+	}
+}
+
+func TestHeaderTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "sdk pipeline/1" {
+			t.Errorf("unexpected User-Agent %q", got)
+		}
+		if got := r.Header.Get(correlationIDHeader); got != "run-id" {
+			t.Errorf("unexpected correlation ID %q", got)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &headerTransport{
+		base:           http.DefaultTransport,
+		userAgentExtra: "pipeline/1",
+		correlationID:  "run-id",
+	}}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("User-Agent", "sdk")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestAuthTransportRejectedToken(t *testing.T) {
+	issued := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issued++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer","expires_in":43199}`, issued)
+	}))
+	defer tokenServer.Close()
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("attempt %d: unexpected body %q", attempts, body)
+		}
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tokens := &refreshingTokenSource{
+		config:     clientcredentials.Config{ClientID: "rejected", ClientSecret: "secret", TokenURL: tokenServer.URL},
+		httpClient: tokenServer.Client(),
+		timeout:    defaultTokenTimeout,
+	}
+	client := &http.Client{Transport: &authTransport{base: http.DefaultTransport, tokens: tokens}}
+
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if attempts != 2 || issued != 2 {
+		t.Errorf("expected 2 attempts with 2 tokens, got %d attempts with %d tokens", attempts, issued)
+	}
+}
+
+func TestAuthTransportStaticToken(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.Header.Get("Authorization") != "Bearer configured" {
+			t.Errorf("unexpected Authorization %q", r.Header.Get("Authorization"))
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &authTransport{base: http.DefaultTransport, tokens: staticTokenSource{accessToken: "configured"}}}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", resp.StatusCode)
+	}
+	if attempts != 1 {
+		t.Errorf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestHTTPClientLogger(t *testing.T) {
+	client, err := newHTTPClient(httpClientSettings{Retry: retrySettings{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Logger != nil {
+		t.Errorf("expected no retryablehttp logger, got %T", client.Logger)
+	}
+
+	authenticated := authenticatedHTTPClient(client, staticTokenSource{accessToken: "configured"})
+	if authenticated.Logger != nil {
+		t.Errorf("expected no retryablehttp logger on the authenticated client, got %T", authenticated.Logger)
+	}
+}
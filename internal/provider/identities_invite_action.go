@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const identitiesInviteDefaultTimeout = 10 * time.Minute
+
+var (
+	_ action.Action                   = &identitiesInviteAction{}
+	_ action.ActionWithConfigure      = &identitiesInviteAction{}
+	_ action.ActionWithValidateConfig = &identitiesInviteAction{}
+)
+
+func NewIdentitiesInviteAction() action.Action {
+	return &identitiesInviteAction{}
+}
+
+type identitiesInviteAction struct {
+	data *providerData
+}
+
+type identitiesInviteActionModel struct {
+	IdentityIDs types.List   `tfsdk:"identity_ids"`
+	Filters     types.String `tfsdk:"filters"`
+	Uninvited   types.Bool   `tfsdk:"uninvited"`
+	Wait        types.Bool   `tfsdk:"wait"`
+	Timeout     types.String `tfsdk:"timeout"`
+}
+
+func (a *identitiesInviteAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identities_invite"
+}
+
+func (a *identitiesInviteAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Emails identities an invitation to register, setting up their password, as when onboarding the admins of a new tenant. Only unregistered identities are invited, and invitations expire after 7 days. The invitation task is reported as it runs. Exactly one of identity_ids, filters or uninvited must be set. The API is experimental.",
+		Attributes: map[string]schema.Attribute{
+			"identity_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{sailPointIDValidator{}},
+				Description: "IDs of the identities invited.",
+			},
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter selecting the identities invited, as supported by the list identities API (ex. email sw \"admin\").",
+			},
+			"uninvited": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to invite every unregistered identity of the tenant, up to 1000 of them.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the invitation task to complete. Defaults to false.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{durationValidator{}},
+				Description: "How long to wait for the invitation task to complete, as a Go duration string (defaults to 10m).",
+			},
+		},
+	}
+}
+
+func (a *identitiesInviteAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Identities Invite action")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.data = data
+}
+
+func (a *identitiesInviteAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config identitiesInviteActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := 0
+	for _, null := range []bool{config.IdentityIDs.IsNull(), config.Filters.IsNull(), config.Uninvited.IsNull()} {
+		if !null {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Identities Invite Target",
+			"Exactly one of identity_ids, filters or uninvited must be set.",
+		)
+		return
+	}
+	if !config.Uninvited.IsNull() && !config.Uninvited.IsUnknown() && !config.Uninvited.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Invalid Identities Invite Target",
+			"uninvited can only be set to true, set identity_ids or filters instead.",
+		)
+		return
+	}
+	a.data.requireExperimental("sailpoint_identities_invite", &resp.Diagnostics)
+}
+
+func (a *identitiesInviteAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Info(ctx, "Invoking Identities Invite")
+	defer a.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var config identitiesInviteActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := a.data.client()
+	if !a.data.experimentalEnabled(client, "sailpoint_identities_invite", &resp.Diagnostics) {
+		return
+	}
+
+	request := api_v2025.InviteIdentitiesRequest{}
+	switch {
+	case !config.IdentityIDs.IsNull():
+		resp.Diagnostics.Append(config.IdentityIDs.ElementsAs(ctx, &request.Ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	case !config.Filters.IsNull():
+		pagination := paginationModel{MaxResults: types.Int64Value(0)}
+		identities, res, err := paginate[api_v2025.Identity](client.V2025.IdentitiesAPI.ListIdentities(ctx).Filters(config.Filters.ValueString()), &pagination)
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, "Unable to Read Identities", err, res)
+			return
+		}
+		for _, identity := range identities {
+			request.Ids = append(request.Ids, identity.GetId())
+		}
+	default:
+		request.Uninvited = config.Uninvited.ValueBoolPointer()
+	}
+
+	// The API would read an empty list as no selection at all.
+	if request.Uninvited == nil && len(request.Ids) == 0 {
+		resp.Diagnostics.AddWarning(
+			"No Identity Invited",
+			"No identity matches the filters, no invitation was sent.",
+		)
+		return
+	}
+
+	task, res, err := client.V2025.IdentitiesAPI.StartIdentitiesInvite(ctx).InviteIdentitiesRequest(request).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Invite Identities", err, res)
+		return
+	}
+
+	taskID := task.GetId()
+	if request.Uninvited != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Inviting the unregistered identities, task %s", taskID)})
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Inviting %d identities, task %s", len(request.Ids), taskID)})
+	}
+	if !config.Wait.ValueBool() {
+		return
+	}
+
+	timeout := timeoutValue(config.Timeout, identitiesInviteDefaultTimeout)
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	name := "Identities invite task " + taskID
+	status, res, err := waitTaskStatus(pollCtx, client, name, taskID)
+	if err != nil {
+		addTaskPollError(ctx, &resp.Diagnostics, "Identities Invite", name, timeout, status, err, res)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Identities invite task %s completed with status %s", taskID, status)})
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idIdentitySchema is the identity of the resources identified by their id
+// attribute, the SailPoint ID of an object for most of them, which import
+// blocks can set instead of an import ID on Terraform 1.12 and later.
+var idIdentitySchema = identityschema.Schema{
+	Attributes: map[string]identityschema.Attribute{
+		"id": identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       "ID of the resource, as in its id attribute: the SailPoint ID of its object for most resources.",
+		},
+	},
+}
+
+// idIdentityModel maps idIdentitySchema.
+type idIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// setIDIdentity sets the identity of a resource to the ID of its object.
+// Terraform versions without resource identity send no identity.
+func setIDIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+	diags.Append(identity.Set(ctx, idIdentityModel{ID: id})...)
+}
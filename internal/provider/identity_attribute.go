@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	identityAttributeSourceAttrTypes = map[string]attr.Type{
+		"type":       types.StringType,
+		"properties": normalizedJSONType{},
+	}
+	identityAttributeDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Technical name of the identity attribute.",
+		},
+		"display_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the identity attribute shown in the UI.",
+		},
+		"type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the values of the attribute, such as string.",
+		},
+		"standard": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the attribute is one of the standard attributes of identities.",
+		},
+		"multi": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the attribute has multiple values.",
+		},
+		"searchable": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the attribute can be searched.",
+		},
+		"system": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the attribute is managed by the system and can't be changed.",
+		},
+		"sources": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: identityAttributeSourceAttrTypes},
+			Description: "How the attribute value is derived, the properties of each source are JSON encoded",
+		},
+	}
+)
+
+type identityAttributeSourceModel struct {
+	Type       types.String        `tfsdk:"type"`
+	Properties normalizedJSONValue `tfsdk:"properties"`
+}
+
+type identityAttributeModel struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Type        types.String `tfsdk:"type"`
+	Standard    types.Bool   `tfsdk:"standard"`
+	Multi       types.Bool   `tfsdk:"multi"`
+	Searchable  types.Bool   `tfsdk:"searchable"`
+	System      types.Bool   `tfsdk:"system"`
+	Sources     types.List   `tfsdk:"sources"`
+}
+
+type identityAttributesDataSourceModel struct {
+	APIVersion         types.String             `tfsdk:"api_version"`
+	IncludeSystem      types.Bool               `tfsdk:"include_system"`
+	IncludeSilent      types.Bool               `tfsdk:"include_silent"`
+	SearchableOnly     types.Bool               `tfsdk:"searchable_only"`
+	IdentityAttributes []identityAttributeModel `tfsdk:"identity_attributes"`
+}
+
+func serializeIdentityAttributeData(ctx context.Context, attribute api_v2025.IdentityAttribute) (identityAttributeModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sources := make([]identityAttributeSourceModel, 0, len(attribute.Sources))
+	for _, source := range attribute.Sources {
+		properties := normalizedJSONNull()
+		if source.Properties != nil {
+			propertiesBytes, err := json.Marshal(source.Properties)
+			if err != nil {
+				diags.AddError(
+					"Unable to serialize identity attribute source properties",
+					err.Error(),
+				)
+				return identityAttributeModel{}, diags
+			}
+			properties = normalizedJSONStringValue(string(propertiesBytes))
+		}
+
+		sources = append(sources, identityAttributeSourceModel{
+			Type:       types.StringPointerValue(source.Type),
+			Properties: properties,
+		})
+	}
+
+	sourcesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: identityAttributeSourceAttrTypes}, sources)
+	if diags.HasError() {
+		return identityAttributeModel{}, diags
+	}
+
+	return identityAttributeModel{
+		Name:        types.StringValue(attribute.GetName()),
+		DisplayName: types.StringPointerValue(attribute.DisplayName),
+		Type:        types.StringPointerValue(attribute.Type.Get()),
+		Standard:    types.BoolPointerValue(attribute.Standard),
+		Multi:       types.BoolPointerValue(attribute.Multi),
+		Searchable:  types.BoolPointerValue(attribute.Searchable),
+		System:      types.BoolPointerValue(attribute.System),
+		Sources:     sourcesList,
+	}, nil
+}
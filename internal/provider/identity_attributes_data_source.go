@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &identityAttributesDataSource{}
+	_ datasource.DataSourceWithConfigure = &identityAttributesDataSource{}
+)
+
+func NewIdentityAttributesDataSource() datasource.DataSource {
+	return &identityAttributesDataSource{}
+}
+
+type identityAttributesDataSource struct {
+	data *providerData
+}
+
+func (d *identityAttributesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_attributes"
+}
+
+func (d *identityAttributesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the identity attributes of the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"include_system": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to include the system attributes, which don't have a source and aren't configurable",
+			},
+			"include_silent": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to include the silent attributes",
+			},
+			"searchable_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to only return the searchable attributes",
+			},
+			"identity_attributes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Identity attributes of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: identityAttributeDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *identityAttributesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint IdentityAttributes data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *identityAttributesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Identity Attributes")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state identityAttributesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := client.V2025.IdentityAttributesAPI.ListIdentityAttributes(ctx)
+	if !state.IncludeSystem.IsNull() {
+		request = request.IncludeSystem(state.IncludeSystem.ValueBool())
+	}
+	if !state.IncludeSilent.IsNull() {
+		request = request.IncludeSilent(state.IncludeSilent.ValueBool())
+	}
+	if !state.SearchableOnly.IsNull() {
+		request = request.SearchableOnly(state.SearchableOnly.ValueBool())
+	}
+
+	results, res, err := request.Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Identity Attributes", err, res)
+		return
+	}
+
+	state.IdentityAttributes = make([]identityAttributeModel, 0, len(results))
+	for _, attribute := range results {
+		attributeState, diags := serializeIdentityAttributeData(ctx, attribute)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.IdentityAttributes = append(state.IdentityAttributes, attributeState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const identityLifecycleStateDefaultTimeout = 10 * time.Minute
+
+var (
+	_ action.Action              = &identityLifecycleStateAction{}
+	_ action.ActionWithConfigure = &identityLifecycleStateAction{}
+)
+
+func NewIdentityLifecycleStateAction() action.Action {
+	return &identityLifecycleStateAction{}
+}
+
+type identityLifecycleStateAction struct {
+	data *providerData
+}
+
+type identityLifecycleStateActionModel struct {
+	IdentityID       types.String `tfsdk:"identity_id"`
+	LifecycleStateID types.String `tfsdk:"lifecycle_state_id"`
+	Wait             types.Bool   `tfsdk:"wait"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+func (a *identityLifecycleStateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_lifecycle_state"
+}
+
+func (a *identityLifecycleStateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Moves an identity to a lifecycle state of its identity profile, as when testing joiner and leaver scenarios, optionally waiting for the provisioning of the new state (account enabling or disabling, access changes) to complete.",
+		Attributes: map[string]schema.Attribute{
+			"identity_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the identity.",
+			},
+			"lifecycle_state_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the lifecycle state, which must belong to the identity profile of the identity.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the account activity provisioning the lifecycle state to complete. Defaults to false.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{durationValidator{}},
+				Description: "How long to wait for the provisioning to complete, as a Go duration string (defaults to 10m).",
+			},
+		},
+	}
+}
+
+func (a *identityLifecycleStateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Identity Lifecycle State action")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.data = data
+}
+
+func (a *identityLifecycleStateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Info(ctx, "Invoking Identity Lifecycle State")
+	defer a.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var config identityLifecycleStateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := a.data.client()
+	identityID := config.IdentityID.ValueString()
+	request := api_v2025.SetLifecycleStateRequest{LifecycleStateId: config.LifecycleStateID.ValueStringPointer()}
+	result, res, err := client.V2025.LifecycleStatesAPI.SetLifecycleState(ctx, identityID).SetLifecycleStateRequest(request).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, fmt.Sprintf("Unable to Set Lifecycle State of Identity %s", identityID), err, res)
+		return
+	}
+
+	activityID := result.GetAccountActivityId()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Set lifecycle state %s on identity %s, account activity %s", config.LifecycleStateID.ValueString(), identityID, activityID)})
+	if !config.Wait.ValueBool() {
+		return
+	}
+	if activityID == "" {
+		resp.Diagnostics.AddWarning(
+			"Lifecycle State Provisioning Not Awaited",
+			fmt.Sprintf("Setting the lifecycle state of identity %s returned no account activity to wait for.", identityID),
+		)
+		return
+	}
+
+	timeout := timeoutValue(config.Timeout, identityLifecycleStateDefaultTimeout)
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	task := "Account activity " + activityID
+	status, res, err := waitAccountActivity(pollCtx, client, task, activityID)
+	if err != nil {
+		addTaskPollError(ctx, &resp.Diagnostics, "Lifecycle State Provisioning", task, timeout, status, err, res)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Account activity %s completed with status %s", activityID, status)})
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const identityProcessingDefaultTimeout = 30 * time.Minute
+
+var (
+	_ action.Action                   = &identityProcessingAction{}
+	_ action.ActionWithConfigure      = &identityProcessingAction{}
+	_ action.ActionWithValidateConfig = &identityProcessingAction{}
+)
+
+func NewIdentityProcessingAction() action.Action {
+	return &identityProcessingAction{}
+}
+
+type identityProcessingAction struct {
+	data *providerData
+}
+
+type identityProcessingActionModel struct {
+	IdentityIDs       types.List   `tfsdk:"identity_ids"`
+	IdentityProfileID types.String `tfsdk:"identity_profile_id"`
+	Wait              types.Bool   `tfsdk:"wait"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+func (a *identityProcessingAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_processing"
+}
+
+func (a *identityProcessingAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Processes identities, recalculating their attributes, roles, access and manager, so changes of attribute mappings or lifecycle states take effect within the apply which makes them instead of at the next scheduled processing. Either identity_ids, whose API is experimental, or identity_profile_id must be set.",
+		Attributes: map[string]schema.Attribute{
+			"identity_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{sailPointIDValidator{}},
+				Description: "IDs of the identities processed. Requires the experimental provider setting.",
+			},
+			"identity_profile_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the identity profile whose identities are all processed.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the processing of identity_ids to complete. The processing of an identity profile can't be waited for. Defaults to false.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{durationValidator{}},
+				Description: "How long to wait for the processing to complete, as a Go duration string (defaults to 30m).",
+			},
+		},
+	}
+}
+
+func (a *identityProcessingAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Identity Processing action")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.data = data
+}
+
+func (a *identityProcessingAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config identityProcessingActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IdentityIDs.IsNull() == config.IdentityProfileID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Identity Processing Target",
+			"Exactly one of identity_ids or identity_profile_id must be set.",
+		)
+		return
+	}
+	if !config.IdentityIDs.IsNull() {
+		a.data.requireExperimental("sailpoint_identity_processing with identity_ids", &resp.Diagnostics)
+	}
+}
+
+func (a *identityProcessingAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Info(ctx, "Invoking Identity Processing")
+	defer a.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var config identityProcessingActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := a.data.client()
+
+	if !config.IdentityProfileID.IsNull() {
+		profileID := config.IdentityProfileID.ValueString()
+		_, res, err := client.V2025.IdentityProfilesAPI.SyncIdentityProfile(ctx, profileID).Execute()
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, "Unable to Process Identity Profile", err, res)
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started processing the identities of identity profile %s", profileID)})
+		return
+	}
+
+	if !a.data.experimentalEnabled(client, "sailpoint_identity_processing with identity_ids", &resp.Diagnostics) {
+		return
+	}
+
+	request := api_v2025.NewProcessIdentitiesRequest()
+	resp.Diagnostics.Append(config.IdentityIDs.ElementsAs(ctx, &request.IdentityIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, res, err := client.V2025.IdentitiesAPI.StartIdentityProcessing(ctx).ProcessIdentitiesRequest(*request).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Process Identities", err, res)
+		return
+	}
+
+	taskID := task.GetId()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Processing %d identities, task %s", len(request.IdentityIds), taskID)})
+	if !config.Wait.ValueBool() {
+		return
+	}
+
+	timeout := timeoutValue(config.Timeout, identityProcessingDefaultTimeout)
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status, res, err := waitTaskStatus(pollCtx, client, "Identity processing task "+taskID, taskID)
+	if err != nil {
+		addTaskPollError(ctx, &resp.Diagnostics, "Identity Processing", "Identity processing task "+taskID, timeout, status, err, res)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Identity processing task %s completed with status %s", taskID, status)})
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &identityProfilePrioritiesListResource{}
+	_ list.ListResourceWithConfigure = &identityProfilePrioritiesListResource{}
+)
+
+func NewIdentityProfilePrioritiesListResource() list.ListResource {
+	return &identityProfilePrioritiesListResource{}
+}
+
+// identityProfilePrioritiesListResource lists the order of the identity
+// profiles of the tenant, of which there is a single one.
+type identityProfilePrioritiesListResource struct {
+	data *providerData
+}
+
+func (l *identityProfilePrioritiesListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_profile_priorities"
+}
+
+func (l *identityProfilePrioritiesListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the order of the identity profiles of the tenant, a single result ordering the profiles by priority.",
+		Attributes:  map[string]schema.Attribute{},
+	}
+}
+
+func (l *identityProfilePrioritiesListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint IdentityProfilePriorities list resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.data = data
+}
+
+func (l *identityProfilePrioritiesListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, "Listing Identity Profile Priorities")
+
+	profiles, diags := listIdentityProfilePriorities(ctx, l.data.client())
+	l.data.rateLimit.warnRateLimit(&diags)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	result := req.NewListResult(ctx)
+	result.DisplayName = "Identity profile priorities"
+	result.Diagnostics.Append(diags...)
+	setIDIdentity(ctx, result.Identity, types.StringValue(identityProfilePrioritiesID), &result.Diagnostics)
+
+	if req.IncludeResource {
+		state := identityProfilePrioritiesResourceModel{ID: types.StringValue(identityProfilePrioritiesID)}
+		ids, priorities := orderIdentityProfiles(nil, profiles)
+		result.Diagnostics.Append(state.setPriorities(ctx, ids, priorities)...)
+		if !result.Diagnostics.HasError() {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		push(result)
+	}
+}
@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+// identityProfilePrioritiesID is the ID of the resource, there is a single
+// ordering of the identity profiles per tenant.
+const identityProfilePrioritiesID = "identity_profile_priorities"
+
+// identityProfilePriorityStep separates the priorities given to consecutive
+// identity profiles, leaving room for profiles prioritized in the UI.
+const identityProfilePriorityStep = 10
+
+const identityProfilePrioritiesDefaultTimeout = 10 * time.Minute
+
+var (
+	_ resource.Resource                 = &identityProfilePrioritiesResource{}
+	_ resource.ResourceWithConfigure    = &identityProfilePrioritiesResource{}
+	_ resource.ResourceWithImportState  = &identityProfilePrioritiesResource{}
+	_ resource.ResourceWithIdentity     = &identityProfilePrioritiesResource{}
+	_ resource.ResourceWithUpgradeState = &identityProfilePrioritiesResource{}
+	_ resource.ResourceWithModifyPlan   = &identityProfilePrioritiesResource{}
+)
+
+var identityProfilePrioritiesStateUpgrades []stateUpgrade
+
+func NewIdentityProfilePrioritiesResource() resource.Resource {
+	return &identityProfilePrioritiesResource{}
+}
+
+type identityProfilePrioritiesResource struct {
+	client             *sailpoint.APIClient
+	rateLimit          *rateLimitUsage
+	validateReferences bool
+}
+
+type identityProfilePrioritiesResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	IdentityProfileIDs types.List     `tfsdk:"identity_profile_ids"`
+	Priorities         types.Map      `tfsdk:"priorities"`
+	Timeouts           *timeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *identityProfilePrioritiesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_profile_priorities"
+}
+
+func (r *identityProfilePrioritiesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     stateVersion(identityProfilePrioritiesStateUpgrades),
+		Description: fmt.Sprintf("Orders every identity profile of the tenant as a single list, rather than setting the priority of each profile on its own, which lets two profiles get the same priority. The profiles are given priorities %d, %d, %d... in list order, the lowest priority taking precedence when an identity has accounts on the authoritative sources of several profiles. Only the profiles whose priority changes are updated. Destroying the resource leaves the priorities as they are.", identityProfilePriorityStep, 2*identityProfilePriorityStep, 3*identityProfilePriorityStep),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Identifier of the resource, always " + identityProfilePrioritiesID + ".",
+			},
+			"identity_profile_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{sailPointIDValidator{}},
+				Description: "IDs of every identity profile of the tenant, from the one taking precedence to the last one. Profiles created outside of Terraform appear at the end of the list in the next plan, and must be added to it.",
+			},
+			"priorities": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "Priorities of the identity profiles, by ID.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsResourceSchemaBlock,
+		},
+	}
+}
+
+func (r *identityProfilePrioritiesResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(identityProfilePrioritiesStateUpgrades)
+}
+
+func (r *identityProfilePrioritiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint IdentityProfilePriorities resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client()
+	r.rateLimit = data.rateLimit
+	r.validateReferences = data.validateReferences
+}
+
+func (r *identityProfilePrioritiesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing references other objects in destroy plans.
+	if !r.validateReferences || req.Plan.Raw.IsNull() {
+		return
+	}
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	references := planReferences(ctx, req, map[string]referenceKind{
+		"identity_profile_ids": identityProfileReference,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkReferences(ctx, r.client, references, &resp.Diagnostics)
+}
+
+func (r *identityProfilePrioritiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating identity profile priorities resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan identityProfilePrioritiesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.create(identityProfilePrioritiesDefaultTimeout))
+	defer cancel()
+
+	plan.ID = types.StringValue(identityProfilePrioritiesID)
+	resp.Diagnostics.Append(r.prioritize(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIDIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+func (r *identityProfilePrioritiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading identity profile priorities resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var state identityProfilePrioritiesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, state.Timeouts.read(identityProfilePrioritiesDefaultTimeout))
+	defer cancel()
+
+	profiles, diags := listIdentityProfilePriorities(ctx, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An import has no list yet.
+	var ids []string
+	if !state.IdentityProfileIDs.IsNull() {
+		resp.Diagnostics.Append(state.IdentityProfileIDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The list follows the priorities of the tenant, so profiles reordered
+	// outside of Terraform are put back in order in the next plan, and an
+	// imported list takes the order of the tenant.
+	ids, priorities := orderIdentityProfiles(ids, profiles)
+	resp.Diagnostics.Append(state.setPriorities(ctx, ids, priorities)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	setIDIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
+}
+
+func (r *identityProfilePrioritiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating identity profile priorities resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan identityProfilePrioritiesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.update(identityProfilePrioritiesDefaultTimeout))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.prioritize(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIDIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+func (r *identityProfilePrioritiesResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The profiles keep their priorities, removing the resource from the
+	// state is enough.
+	tflog.Info(ctx, "deleting identity profile priorities resource")
+}
+
+func (r *identityProfilePrioritiesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
+// ImportState imports the order of the identity profiles of the tenant, by
+// the ID of the resource or by identity.
+func (r *identityProfilePrioritiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" && req.ID != identityProfilePrioritiesID {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("There is a single order of the identity profiles per tenant, expected the import ID %q, got: %q", identityProfilePrioritiesID, req.ID),
+		)
+		return
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// listIdentityProfilePriorities returns the priority of every identity
+// profile of the tenant, by ID.
+func listIdentityProfilePriorities(ctx context.Context, client *sailpoint.APIClient) (map[string]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	pagination := paginationModel{MaxResults: types.Int64Value(0)}
+	profiles, res, err := paginate[api_v2025.IdentityProfile](client.V2025.IdentityProfilesAPI.ListIdentityProfiles(ctx), &pagination)
+	if err != nil {
+		addAPIError(ctx, &diags, "Unable to Read Identity Profiles", err, res)
+		return nil, diags
+	}
+
+	priorities := make(map[string]int64, len(profiles))
+	for _, profile := range profiles {
+		priorities[profile.GetId()] = profile.GetPriority()
+	}
+	return priorities, diags
+}
+
+// prioritize gives the identity profiles of plan the priorities of their
+// position. Every profile of the tenant must be listed exactly once, which is
+// checked before any profile is updated.
+func (r *identityProfilePrioritiesResource) prioritize(ctx context.Context, plan *identityProfilePrioritiesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var ids []string
+	diags.Append(plan.IdentityProfileIDs.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, listDiags := listIdentityProfilePriorities(ctx, r.client)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if problem := identityProfileOrderProblem(ids, current); problem != "" {
+		diags.AddAttributeError(path.Root("identity_profile_ids"), "Invalid Identity Profile Order", problem)
+		return diags
+	}
+
+	priorities := make(map[string]int64, len(ids))
+	for i, id := range ids {
+		priority := int64(i+1) * identityProfilePriorityStep
+		priorities[id] = priority
+		if current[id] == priority {
+			continue
+		}
+
+		tflog.Debug(ctx, "Updating identity profile priority", map[string]any{"identity_profile_id": id, "priority": priority})
+		value := int32(priority)
+		operation := api_v2025.NewJsonPatchOperation("replace", "/priority")
+		operation.Value = &api_v2025.UpdateMultiHostSourcesRequestInnerValue{Int32: &value}
+		_, res, err := r.client.V2025.IdentityProfilesAPI.UpdateIdentityProfile(ctx, id).JsonPatchOperation([]api_v2025.JsonPatchOperation{*operation}).Execute()
+		if err != nil {
+			addAPIError(ctx, &diags, fmt.Sprintf("Unable to Update Priority of Identity Profile %s", id), err, res)
+			return diags
+		}
+	}
+
+	diags.Append(plan.setPriorities(ctx, ids, priorities)...)
+	return diags
+}
+
+func (m *identityProfilePrioritiesResourceModel) setPriorities(ctx context.Context, ids []string, priorities map[string]int64) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+	m.IdentityProfileIDs, d = types.ListValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	m.Priorities, d = types.MapValueFrom(ctx, types.Int64Type, priorities)
+	diags.Append(d...)
+	return diags
+}
+
+// identityProfileOrderProblem describes why ids isn't an order of the
+// identity profiles of the tenant, or returns "" when it is.
+func identityProfileOrderProblem(ids []string, profiles map[string]int64) string {
+	var problems []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		_, ok := profiles[id]
+		switch {
+		case seen[id]:
+			problems = append(problems, fmt.Sprintf("identity profile %s is listed more than once", id))
+		case !ok:
+			problems = append(problems, fmt.Sprintf("identity profile %s doesn't exist", id))
+		}
+		seen[id] = true
+	}
+
+	var missing []string
+	for id := range profiles {
+		if !seen[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		problems = append(problems, "identity profiles "+strings.Join(missing, ", ")+" are not listed, every identity profile of the tenant must be")
+	}
+	return strings.Join(problems, "; ")
+}
+
+// orderIdentityProfiles returns the IDs of the identity profiles of the
+// tenant by ascending priority, with their priorities. Profiles with the same
+// priority keep their order in ids, before those missing from ids. Profiles
+// of ids which don't exist anymore are left out.
+func orderIdentityProfiles(ids []string, profiles map[string]int64) ([]string, map[string]int64) {
+	position := make(map[string]int, len(profiles))
+	for i, id := range ids {
+		_, listed := position[id]
+		_, ok := profiles[id]
+		if !listed && ok {
+			position[id] = i
+		}
+	}
+	ordered := make([]string, 0, len(profiles))
+	for id := range profiles {
+		ordered = append(ordered, id)
+	}
+
+	slices.SortFunc(ordered, func(a, b string) int {
+		if profiles[a] != profiles[b] {
+			return cmp.Compare(profiles[a], profiles[b])
+		}
+		positionA, listedA := position[a]
+		positionB, listedB := position[b]
+		switch {
+		case listedA && listedB:
+			return positionA - positionB
+		case listedA != listedB:
+			if listedA {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	return ordered, profiles
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestIdentityProfileOrderProblem(t *testing.T) {
+	profiles := map[string]int64{"a": 10, "b": 20, "c": 30}
+
+	if problem := identityProfileOrderProblem([]string{"c", "a", "b"}, profiles); problem != "" {
+		t.Errorf("unexpected problem %q", problem)
+	}
+
+	problem := identityProfileOrderProblem([]string{"a", "a", "d"}, profiles)
+	for _, want := range []string{"a is listed more than once", "d doesn't exist", "b, c are not listed"} {
+		if !strings.Contains(problem, want) {
+			t.Errorf("expected %q in %q", want, problem)
+		}
+	}
+}
+
+func TestOrderIdentityProfiles(t *testing.T) {
+	profiles := map[string]int64{"a": 20, "b": 10, "c": 20, "d": 20, "e": 5}
+
+	ordered, _ := orderIdentityProfiles([]string{"c", "a", "gone", "b"}, profiles)
+	want := []string{"e", "b", "c", "a", "d"}
+	if !slices.Equal(ordered, want) {
+		t.Errorf("expected %v, got %v", want, ordered)
+	}
+}
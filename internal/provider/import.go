@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importNamePrefix marks import IDs which are object names to resolve, as
+// in terraform import sailpoint_managed_cluster.example name:Production.
+const importNamePrefix = "name:"
+
+// importStateByIDOrName imports the object identified by the import ID, which
+// is either the ID of the object or its name prefixed with name:. Names are
+// resolved with lookup, which returns the IDs of the objects with that name,
+// and must match exactly one object. Import blocks without an import ID set
+// the idIdentitySchema identity instead.
+func importStateByIDOrName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, objectType string, lookup func(ctx context.Context, name string) ([]string, *http.Response, error)) {
+	name, byName := strings.CutPrefix(req.ID, importNamePrefix)
+	if !byName {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	if name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the ID of the %s or %s<name>, got: %q", objectType, importNamePrefix, req.ID),
+		)
+		return
+	}
+
+	ids, res, err := lookup(ctx, name)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, fmt.Sprintf("Unable to Find %s %q", objectType, name), err, res)
+		return
+	}
+
+	switch len(ids) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Cannot Import Non-Existent Object",
+			fmt.Sprintf("No %s is named %q.", objectType, name),
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous Import Name",
+			fmt.Sprintf("%d objects of type %s are named %q, import one of them by ID instead: %s.", len(ids), objectType, name, strings.Join(ids, ", ")),
+		)
+	}
+}
+
+// addAlreadyExistsError reports that the object a resource would create
+// already exists, explaining how to import it instead, as when moving from
+// a configuration only reading it with a data source.
+func addAlreadyExistsError(diags *diag.Diagnostics, resourceType, objectType, name string, ids []string) {
+	diags.AddError(
+		"Object Already Exists",
+		fmt.Sprintf("A %s named %q already exists (%s). To manage it without creating it again, import it with an import block:\n\n"+
+			"import {\n  to = %s.<name>\n  id = %q\n}\n\n"+
+			"or with terraform import %s.<name> %s, then remove the data source reading it if any.",
+			objectType, name, strings.Join(ids, ", "), resourceType, ids[0], resourceType, ids[0]),
+	)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	launcherDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the launcher.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the launcher.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the launcher.",
+		},
+		"type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the launcher, INTERACTIVE_PROCESS.",
+		},
+		"disabled": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the launcher is disabled.",
+		},
+		"owner": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: typedReferenceAttrTypes,
+			Description:    "Owner of the launcher.",
+		},
+		"reference": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: typedReferenceAttrTypes,
+			Description:    "The object launched by the launcher (ex. type WORKFLOW and the workflow ID)",
+		},
+		"config": dataSchema.StringAttribute{
+			CustomType:  normalizedJSONType{},
+			Computed:    true,
+			Description: "JSON encoded configuration of the launcher.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the launcher.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the launcher.",
+		},
+	}
+)
+
+type launcherModel struct {
+	ID          types.String        `tfsdk:"id"`
+	Name        types.String        `tfsdk:"name"`
+	Description types.String        `tfsdk:"description"`
+	Type        types.String        `tfsdk:"type"`
+	Disabled    types.Bool          `tfsdk:"disabled"`
+	Owner       types.Object        `tfsdk:"owner"`
+	Reference   types.Object        `tfsdk:"reference"`
+	Config      normalizedJSONValue `tfsdk:"config"`
+	Created     rfc3339Value        `tfsdk:"created"`
+	Modified    rfc3339Value        `tfsdk:"modified"`
+}
+
+type launchersDataSourceModel struct {
+	APIVersion types.String    `tfsdk:"api_version"`
+	Filters    types.String    `tfsdk:"filters"`
+	Launchers  []launcherModel `tfsdk:"launchers"`
+}
+
+func serializeLauncherData(ctx context.Context, launcher api_v2025.Launcher) (launcherModel, diag.Diagnostics) {
+	owner, diags := types.ObjectValueFrom(ctx, typedReferenceAttrTypes, typedReferenceModel{
+		Type: types.StringValue(launcher.Owner.GetType()),
+		ID:   types.StringValue(launcher.Owner.GetId()),
+	})
+	if diags.HasError() {
+		return launcherModel{}, diags
+	}
+
+	reference := types.ObjectNull(typedReferenceAttrTypes)
+	if launcher.Reference != nil {
+		reference, diags = types.ObjectValueFrom(ctx, typedReferenceAttrTypes, typedReferenceModel{
+			Type: types.StringPointerValue(launcher.Reference.Type),
+			ID:   types.StringPointerValue(launcher.Reference.Id),
+		})
+		if diags.HasError() {
+			return launcherModel{}, diags
+		}
+	}
+
+	return launcherModel{
+		ID:          types.StringValue(launcher.GetId()),
+		Name:        types.StringValue(launcher.GetName()),
+		Description: types.StringValue(launcher.GetDescription()),
+		Type:        types.StringValue(launcher.GetType()),
+		Disabled:    types.BoolValue(launcher.GetDisabled()),
+		Owner:       owner,
+		Reference:   reference,
+		Config:      normalizedJSONStringValue(launcher.GetConfig()),
+		Created:     sailPointTimeValue(&launcher.Created),
+		Modified:    sailPointTimeValue(&launcher.Modified),
+	}, nil
+}
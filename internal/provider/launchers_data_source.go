@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &launchersDataSource{}
+	_ datasource.DataSourceWithConfigure = &launchersDataSource{}
+)
+
+func NewLaunchersDataSource() datasource.DataSource {
+	return &launchersDataSource{}
+}
+
+type launchersDataSource struct {
+	data *providerData
+}
+
+func (d *launchersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_launchers"
+}
+
+func (d *launchersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the launchers of the tenant, which let users start workflows.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters (supports name sw, description sw and disabled eq)",
+			},
+			"launchers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Launchers of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: launcherDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *launchersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Launchers data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *launchersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Launchers")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state launchersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Launchers filters", map[string]any{"filters": filters})
+
+	// The launchers API paginates with a "next" marker instead of offsets,
+	// so the SDK paginator can't be used here.
+	results := make([]v2025.Launcher, 0)
+	next := ""
+	for {
+		request := client.V2025.LaunchersAPI.GetLaunchers(ctx)
+		if filters != "" {
+			request = request.Filters(filters)
+		}
+		if next != "" {
+			request = request.Next(next)
+		}
+
+		page, res, err := request.Execute()
+
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, "Unable to Read Launchers", err, res)
+			return
+		}
+
+		results = append(results, page.Items...)
+
+		next = page.GetNext()
+		if next == "" || len(page.Items) == 0 {
+			break
+		}
+	}
+
+	state.Launchers = make([]launcherModel, 0, len(results))
+	for _, launcher := range results {
+		launcherState, diags := serializeLauncherData(ctx, launcher)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Launchers = append(state.Launchers, launcherState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource                   = &machineAccountsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &machineAccountsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &machineAccountsDataSource{}
+)
+
+func NewMachineAccountsDataSource() datasource.DataSource {
+	return &machineAccountsDataSource{}
+}
+
+type machineAccountsDataSource struct {
+	data *providerData
+}
+
+func (d *machineAccountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_accounts"
+}
+
+func (d *machineAccountsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the machine accounts of the tenant, the accounts of sources classified as used by machines rather than people.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters",
+			},
+			"machine_accounts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Machine accounts of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: machineAccountDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *machineAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint MachineAccounts data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// ValidateConfig rejects the data source at plan time when the provider
+// doesn't enable experimental APIs.
+func (d *machineAccountsDataSource) ValidateConfig(_ context.Context, _ datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	d.data.requireExperimental("sailpoint_machine_accounts", &resp.Diagnostics)
+}
+
+func (d *machineAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Machine Accounts")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state machineAccountsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !d.data.experimentalEnabled(client, "sailpoint_machine_accounts", &resp.Diagnostics) {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Machine Accounts filters", map[string]any{"filters": filters})
+
+	request := client.V2025.MachineAccountsAPI.ListMachineAccounts(ctx)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+	if !state.Sorters.IsNull() {
+		request = request.Sorters(state.Sorters.ValueString())
+	}
+
+	results, res, err := paginate[v2025.MachineAccount](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Machine Accounts", err, res)
+		return
+	}
+
+	state.MachineAccounts = make([]machineAccountModel, 0, len(results))
+	for _, account := range results {
+		accountState, diags := serializeMachineAccountData(ctx, account)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.MachineAccounts = append(state.MachineAccounts, accountState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	machineIdentityDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the machine identity.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the machine identity.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the machine identity.",
+		},
+		"business_application": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Business application the machine identity belongs to.",
+		},
+		"subtype": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Subtype of the machine identity, such as Application or Service Account.",
+		},
+		"native_identity": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Identity of the machine identity in the source.",
+		},
+		"uuid": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Universal identifier of the machine identity in the source.",
+		},
+		"source": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: namedReferenceAttrTypes,
+			Description:    "Source of the machine identity.",
+		},
+		"primary_owner": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: namedReferenceAttrTypes,
+			Description:    "Identity primarily owning the machine identity.",
+		},
+		"secondary_owners": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: namedReferenceAttrTypes},
+			Description: "Other identities owning the machine identity.",
+		},
+		"attributes": dataSchema.StringAttribute{
+			CustomType:  normalizedJSONType{},
+			Computed:    true,
+			Description: "JSON encoded attributes of the machine identity",
+		},
+		"manually_created": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the machine identity was created manually rather than aggregated.",
+		},
+		"manually_edited": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the machine identity was edited manually.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the machine identity.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the machine identity.",
+		},
+	}
+	machineAccountDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the machine account.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the machine account.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the machine account.",
+		},
+		"native_identity": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Identity of the account in the source.",
+		},
+		"uuid": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Universal identifier of the account in the source.",
+		},
+		"classification_method": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "How the account was classified as a machine account (ex. SOURCE, CRITERIA, DISCOVERY, MANUAL)",
+		},
+		"access_type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Access type of the account, such as direct or delegated.",
+		},
+		"subtype": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Subtype of the account, such as Application or Service Account.",
+		},
+		"environment": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Environment of the account, such as production.",
+		},
+		"source": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: namedReferenceAttrTypes,
+			Description:    "Source of the account.",
+		},
+		"machine_identity": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: namedReferenceAttrTypes,
+			Description:    "Machine identity the account is correlated to.",
+		},
+		"owner_identity": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: namedReferenceAttrTypes,
+			Description:    "Identity owning the account.",
+		},
+		"attributes": dataSchema.StringAttribute{
+			CustomType:  normalizedJSONType{},
+			Computed:    true,
+			Description: "JSON encoded attributes of the machine account",
+		},
+		"enabled": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the account is enabled.",
+		},
+		"locked": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the account is locked.",
+		},
+		"has_entitlements": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the account has entitlements.",
+		},
+		"manually_correlated": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the account was correlated to its machine identity manually.",
+		},
+		"manually_edited": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the account was edited manually.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the account.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the account.",
+		},
+	}
+)
+
+type machineIdentityModel struct {
+	ID                  types.String        `tfsdk:"id"`
+	Name                types.String        `tfsdk:"name"`
+	Description         types.String        `tfsdk:"description"`
+	BusinessApplication types.String        `tfsdk:"business_application"`
+	Subtype             types.String        `tfsdk:"subtype"`
+	NativeIdentity      types.String        `tfsdk:"native_identity"`
+	UUID                types.String        `tfsdk:"uuid"`
+	Source              types.Object        `tfsdk:"source"`
+	PrimaryOwner        types.Object        `tfsdk:"primary_owner"`
+	SecondaryOwners     types.List          `tfsdk:"secondary_owners"`
+	Attributes          normalizedJSONValue `tfsdk:"attributes"`
+	ManuallyCreated     types.Bool          `tfsdk:"manually_created"`
+	ManuallyEdited      types.Bool          `tfsdk:"manually_edited"`
+	Created             rfc3339Value        `tfsdk:"created"`
+	Modified            rfc3339Value        `tfsdk:"modified"`
+}
+
+type machineIdentitiesDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters           types.String           `tfsdk:"sorters"`
+	Filters           types.String           `tfsdk:"filters"`
+	MachineIdentities []machineIdentityModel `tfsdk:"machine_identities"`
+}
+
+type machineAccountModel struct {
+	ID                   types.String        `tfsdk:"id"`
+	Name                 types.String        `tfsdk:"name"`
+	Description          types.String        `tfsdk:"description"`
+	NativeIdentity       types.String        `tfsdk:"native_identity"`
+	UUID                 types.String        `tfsdk:"uuid"`
+	ClassificationMethod types.String        `tfsdk:"classification_method"`
+	AccessType           types.String        `tfsdk:"access_type"`
+	Subtype              types.String        `tfsdk:"subtype"`
+	Environment          types.String        `tfsdk:"environment"`
+	Source               types.Object        `tfsdk:"source"`
+	MachineIdentity      types.Object        `tfsdk:"machine_identity"`
+	OwnerIdentity        types.Object        `tfsdk:"owner_identity"`
+	Attributes           normalizedJSONValue `tfsdk:"attributes"`
+	Enabled              types.Bool          `tfsdk:"enabled"`
+	Locked               types.Bool          `tfsdk:"locked"`
+	HasEntitlements      types.Bool          `tfsdk:"has_entitlements"`
+	ManuallyCorrelated   types.Bool          `tfsdk:"manually_correlated"`
+	ManuallyEdited       types.Bool          `tfsdk:"manually_edited"`
+	Created              rfc3339Value        `tfsdk:"created"`
+	Modified             rfc3339Value        `tfsdk:"modified"`
+}
+
+type machineAccountsDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters         types.String          `tfsdk:"sorters"`
+	Filters         types.String          `tfsdk:"filters"`
+	MachineAccounts []machineAccountModel `tfsdk:"machine_accounts"`
+}
+
+func serializeMachineAttributes(attributes map[string]interface{}) (normalizedJSONValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if attributes == nil {
+		return normalizedJSONNull(), diags
+	}
+
+	attributesBytes, err := json.Marshal(attributes)
+	if err != nil {
+		diags.AddError(
+			"Unable to serialize machine attributes",
+			err.Error(),
+		)
+		return normalizedJSONNull(), diags
+	}
+
+	return normalizedJSONStringValue(string(attributesBytes)), diags
+}
+
+func serializeMachineIdentityData(ctx context.Context, identity api_v2025.MachineIdentityResponse) (machineIdentityModel, diag.Diagnostics) {
+	source, diags := serializeReferenceMap(ctx, identity.Source)
+	if diags.HasError() {
+		return machineIdentityModel{}, diags
+	}
+
+	primaryOwner := types.ObjectNull(namedReferenceAttrTypes)
+	secondaryOwners := make([]types.Object, 0)
+	if identity.Owners != nil {
+		primaryOwner, diags = serializeReferenceMap(ctx, identity.Owners.PrimaryIdentity)
+		if diags.HasError() {
+			return machineIdentityModel{}, diags
+		}
+
+		for i := range identity.Owners.SecondaryIdentities {
+			owner, diags := serializeBaseReference(ctx, &identity.Owners.SecondaryIdentities[i])
+			if diags.HasError() {
+				return machineIdentityModel{}, diags
+			}
+			secondaryOwners = append(secondaryOwners, owner)
+		}
+	}
+
+	secondaryOwnersList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: namedReferenceAttrTypes}, secondaryOwners)
+	if diags.HasError() {
+		return machineIdentityModel{}, diags
+	}
+
+	attributes, diags := serializeMachineAttributes(identity.Attributes)
+	if diags.HasError() {
+		return machineIdentityModel{}, diags
+	}
+
+	return machineIdentityModel{
+		ID:                  types.StringPointerValue(identity.Id),
+		Name:                types.StringPointerValue(identity.Name.Get()),
+		Description:         types.StringPointerValue(identity.Description),
+		BusinessApplication: types.StringValue(identity.BusinessApplication),
+		Subtype:             types.StringValue(identity.Subtype),
+		NativeIdentity:      types.StringPointerValue(identity.NativeIdentity),
+		UUID:                types.StringPointerValue(identity.Uuid),
+		Source:              source,
+		PrimaryOwner:        primaryOwner,
+		SecondaryOwners:     secondaryOwnersList,
+		Attributes:          attributes,
+		ManuallyCreated:     types.BoolPointerValue(identity.ManuallyCreated),
+		ManuallyEdited:      types.BoolPointerValue(identity.ManuallyEdited),
+		Created:             sailPointTimeValue(identity.Created),
+		Modified:            sailPointTimeValue(identity.Modified),
+	}, nil
+}
+
+func serializeMachineAccountData(ctx context.Context, account api_v2025.MachineAccount) (machineAccountModel, diag.Diagnostics) {
+	source, diags := serializeReferenceMap(ctx, account.Source)
+	if diags.HasError() {
+		return machineAccountModel{}, diags
+	}
+
+	machineIdentity, diags := serializeReferenceMap(ctx, account.MachineIdentity)
+	if diags.HasError() {
+		return machineAccountModel{}, diags
+	}
+
+	ownerIdentity, diags := serializeReferenceMap(ctx, account.OwnerIdentity)
+	if diags.HasError() {
+		return machineAccountModel{}, diags
+	}
+
+	attributes, diags := serializeMachineAttributes(account.Attributes)
+	if diags.HasError() {
+		return machineAccountModel{}, diags
+	}
+
+	return machineAccountModel{
+		ID:                   types.StringPointerValue(account.Id),
+		Name:                 types.StringPointerValue(account.Name.Get()),
+		Description:          types.StringPointerValue(account.Description.Get()),
+		NativeIdentity:       types.StringValue(account.NativeIdentity),
+		UUID:                 types.StringPointerValue(account.Uuid.Get()),
+		ClassificationMethod: types.StringValue(account.ClassificationMethod),
+		AccessType:           types.StringPointerValue(account.AccessType),
+		Subtype:              types.StringPointerValue(account.Subtype.Get()),
+		Environment:          types.StringPointerValue(account.Environment.Get()),
+		Source:               source,
+		MachineIdentity:      machineIdentity,
+		OwnerIdentity:        ownerIdentity,
+		Attributes:           attributes,
+		Enabled:              types.BoolValue(account.Enabled),
+		Locked:               types.BoolValue(account.Locked),
+		HasEntitlements:      types.BoolValue(account.HasEntitlements),
+		ManuallyCorrelated:   types.BoolPointerValue(account.ManuallyCorrelated),
+		ManuallyEdited:       types.BoolValue(account.ManuallyEdited),
+		Created:              sailPointTimeValue(account.Created),
+		Modified:             sailPointTimeValue(account.Modified),
+	}, nil
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strconv"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &managedClientsDataSource{}
+	_ datasource.DataSourceWithConfigure = &managedClientsDataSource{}
+)
+
+func NewManagedClientsDataSource() datasource.DataSource {
+	return &managedClientsDataSource{}
+}
+
+type managedClientsDataSource struct {
+	data *providerData
+}
+
+type managedClientsDataSourceModel struct {
+	APIVersion     types.String         `tfsdk:"api_version"`
+	ClusterID      types.String         `tfsdk:"cluster_id"`
+	MinimumVersion types.String         `tfsdk:"minimum_version"`
+	ManagedClients []managedClientModel `tfsdk:"managed_clients"`
+}
+
+type managedClientModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ClientID        types.String `tfsdk:"client_id"`
+	ClusterID       types.String `tfsdk:"cluster_id"`
+	Type            types.String `tfsdk:"type"`
+	Status          types.String `tfsdk:"status"`
+	VAVersion       types.String `tfsdk:"va_version"`
+	ProvisionStatus types.String `tfsdk:"provision_status"`
+	LastSeen        rfc3339Value `tfsdk:"last_seen"`
+	IPAddress       types.String `tfsdk:"ip_address"`
+}
+
+func (d *managedClientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_clients"
+}
+
+func (d *managedClientsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the managed clients of the tenant, the virtual appliances of its clusters, with the version they run and their update status, optionally warning about the clients running an older version than expected.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"cluster_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the managed cluster whose clients are listed, every client is listed when omitted.",
+			},
+			"minimum_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version the clients are expected to run at least, a warning being raised for each client running an older one. Versions are compared segment by segment, numbers numerically (ex. va-megapod-useast1-595-1693471245 is older than va-megapod-useast1-610-1701234567).",
+			},
+			"managed_clients": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Managed clients, in the order returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the managed client.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the managed client.",
+						},
+						"client_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the API client of the managed client.",
+						},
+						"cluster_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the cluster of the managed client.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the managed client (ex. VA, CCG).",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the managed client (ex. NORMAL, UNDEFINED).",
+						},
+						"va_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Version the virtual appliance of the managed client runs.",
+						},
+						"provision_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Provisioning status of the managed client, which reports whether it was set up and updated (ex. PROVISIONED, DRAFT).",
+						},
+						"last_seen": schema.StringAttribute{
+							CustomType:  rfc3339Type{},
+							Computed:    true,
+							Description: "When the managed client last polled the tenant. The API has no record of the last update check, this stands in for it.",
+						},
+						"ip_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Public IP address of the managed client.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *managedClientsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ManagedClients data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *managedClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Managed Clients")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state managedClientsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := client.V2025.ManagedClientsAPI.GetManagedClients(ctx)
+	if !state.ClusterID.IsNull() {
+		request = request.Filters("clusterId eq " + quoteFilterString(state.ClusterID.ValueString()))
+	}
+	clients, res, err := paginate[api_v2025.ManagedClient](request, &paginationModel{MaxResults: types.Int64Value(0)})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Managed Clients", err, res)
+		return
+	}
+
+	state.ManagedClients = make([]managedClientModel, 0, len(clients))
+	for _, managedClient := range clients {
+		state.ManagedClients = append(state.ManagedClients, serializeManagedClient(managedClient))
+	}
+	if !state.MinimumVersion.IsNull() {
+		warnOutdatedManagedClients(&resp.Diagnostics, state.ManagedClients, state.MinimumVersion.ValueString())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func serializeManagedClient(managedClient api_v2025.ManagedClient) managedClientModel {
+	return managedClientModel{
+		ID:              types.StringPointerValue(managedClient.Id.Get()),
+		Name:            types.StringPointerValue(managedClient.Name.Get()),
+		ClientID:        types.StringValue(managedClient.ClientId),
+		ClusterID:       types.StringValue(managedClient.ClusterId),
+		Type:            types.StringValue(managedClient.Type),
+		Status:          types.StringPointerValue(managedClient.Status.Get()),
+		VAVersion:       types.StringPointerValue(managedClient.VaVersion.Get()),
+		ProvisionStatus: types.StringPointerValue(managedClient.ProvisionStatus.Get()),
+		LastSeen:        sailPointTimeValue(managedClient.LastSeen.Get()),
+		IPAddress:       types.StringPointerValue(managedClient.IpAddress.Get()),
+	}
+}
+
+// warnOutdatedManagedClients adds a warning for each client running an older
+// version than minimum. Clients which don't report their version are skipped.
+func warnOutdatedManagedClients(diags *diag.Diagnostics, clients []managedClientModel, minimum string) {
+	for _, managedClient := range clients {
+		version := managedClient.VAVersion.ValueString()
+		if version == "" || compareVersions(version, minimum) >= 0 {
+			continue
+		}
+		diags.AddWarning(
+			"Managed Client Outdated",
+			fmt.Sprintf("The managed client %s (%s) of cluster %s runs version %s, older than the minimum version %s.",
+				managedClient.Name.ValueString(), managedClient.ID.ValueString(), managedClient.ClusterID.ValueString(), version, minimum),
+		)
+	}
+}
+
+// compareVersions compares two versions segment by segment, the runs of
+// digits numerically and the other runs as text, so v10 is newer than v9.
+func compareVersions(a, b string) int {
+	segmentsA, segmentsB := versionSegments(a), versionSegments(b)
+	for i := range min(len(segmentsA), len(segmentsB)) {
+		numberA, errA := strconv.ParseUint(segmentsA[i], 10, 64)
+		numberB, errB := strconv.ParseUint(segmentsB[i], 10, 64)
+		c := cmp.Compare(segmentsA[i], segmentsB[i])
+		if errA == nil && errB == nil {
+			c = cmp.Compare(numberA, numberB)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(segmentsA), len(segmentsB))
+}
+
+// versionSegments splits a version into runs of digits and runs of other
+// characters.
+func versionSegments(version string) []string {
+	var segments []string
+	start := 0
+	for i, r := range version {
+		if i > start && unicode.IsDigit(r) != unicode.IsDigit(rune(version[i-1])) {
+			segments = append(segments, version[start:i])
+			start = i
+		}
+	}
+	if start < len(version) {
+		segments = append(segments, version[start:])
+	}
+	return segments
+}
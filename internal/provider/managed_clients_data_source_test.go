@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"va-megapod-useast1-595-1693471245", "va-megapod-useast1-610-1701234567", -1},
+		{"v10", "v9", 1},
+		{"1.2", "1.2", 0},
+		{"1.2", "1.2.1", -1},
+	}
+
+	for _, tc := range cases {
+		if got := compareVersions(tc.a, tc.b); got != tc.expected {
+			t.Errorf("compareVersions(%q, %q): expected %d, got %d", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
+
+func TestManagedClientsDataSourceMinimumVersion(t *testing.T) {
+	fake := newFakeSailPoint(t)
+	fake.respond(http.MethodGet, "/v2025/managed-clients", http.StatusOK, `[
+		{"id": "a1", "name": "va-1", "clientId": "c1", "clusterId": "e1ff7bb24c934240bbf55e1aa39e41c5", "description": "", "type": "VA", "vaVersion": "va-megapod-useast1-595-1693471245", "provisionStatus": "PROVISIONED", "lastSeen": "2026-10-14T08:00:00Z"},
+		{"id": "a2", "name": "va-2", "clientId": "c2", "clusterId": "e1ff7bb24c934240bbf55e1aa39e41c5", "description": "", "type": "VA", "vaVersion": "va-megapod-useast1-610-1701234567", "provisionStatus": "PROVISIONED"}
+	]`)
+
+	state, diags := readTestDataSource(t, NewManagedClientsDataSource(), fake.providerData(), map[string]tftypes.Value{
+		"cluster_id":      tftypes.NewValue(tftypes.String, "e1ff7bb24c934240bbf55e1aa39e41c5"),
+		"minimum_version": tftypes.NewValue(tftypes.String, "va-megapod-useast1-600-0"),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if warnings := diags.Warnings(); len(warnings) != 1 {
+		t.Errorf("expected a warning for va-1, got %v", warnings)
+	}
+
+	var lastSeen rfc3339Value
+	diags.Append(state.GetAttribute(t.Context(), path.Root("managed_clients").AtListIndex(0).AtName("last_seen"), &lastSeen)...)
+	var provisionStatus types.String
+	diags.Append(state.GetAttribute(t.Context(), path.Root("managed_clients").AtListIndex(1).AtName("provision_status"), &provisionStatus)...)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if lastSeen.ValueString() != "2026-10-14T08:00:00Z" || provisionStatus.ValueString() != "PROVISIONED" {
+		t.Errorf("unexpected last_seen %s, provision_status %s", lastSeen, provisionStatus)
+	}
+}
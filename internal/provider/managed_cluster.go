@@ -1,25 +1,33 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
 	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
 )
 
 var (
+	// The attribute types of the nested objects mirror their nested
+	// attributes in the schemas, to build the objects read from the API.
 	managedClusterKeyPairAttrTypes = map[string]attr.Type{
 		"public_key":             types.StringType,
 		"public_key_thumbprint":  types.StringType,
@@ -43,105 +51,188 @@ var (
 	}
 	managedClusterDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
 		"id": dataSchema.StringAttribute{
-			Required: true,
+			Required:    true,
+			Validators:  []validator.String{sailPointIDValidator{}},
+			Description: "ID of the cluster.",
 		},
 		"name": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Name of the cluster.",
 		},
 		"pod": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Pod of the tenant the cluster belongs to.",
 		},
 		"org": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Tenant the cluster belongs to.",
 		},
 		"type": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Type of the cluster, such as idn or iai.",
 		},
 		"configuration": dataSchema.MapAttribute{
 			Computed:    true,
 			ElementType: types.StringType,
+			Description: "Configuration of the cluster, such as its gmtOffset. Values which are not strings are JSON encoded.",
 		},
-		"key_pair": dataSchema.ObjectAttribute{
-			Optional:       true,
-			Computed:       false,
-			AttributeTypes: managedClusterKeyPairAttrTypes,
+		"configuration_json": dataSchema.StringAttribute{
+			CustomType:  normalizedJSONType{},
+			Computed:    true,
+			Description: "JSON encoded configuration of the cluster as returned by the API, keeping the values which are not strings. Those are JSON encoded in configuration.",
 		},
-		"attributes": dataSchema.ObjectAttribute{
-			Computed:       true,
-			AttributeTypes: managedClusterAttributesAttrTypes,
+		"key_pair": dataSchema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Key pair of the cluster, encrypting the credentials sent to its virtual appliances.",
+			Attributes: map[string]dataSchema.Attribute{
+				"public_key": dataSchema.StringAttribute{
+					Computed:    true,
+					Description: "Public key of the cluster, in PEM format.",
+				},
+				"public_key_thumbprint": dataSchema.StringAttribute{
+					Computed:    true,
+					Description: "Thumbprint of the public key.",
+				},
+				"public_key_certificate": dataSchema.StringAttribute{
+					Computed:    true,
+					Description: "Certificate of the public key, in PEM format.",
+				},
+			},
 		},
-		"redis": dataSchema.ObjectAttribute{
-			Computed:       true,
-			AttributeTypes: managedClusterRedisAttrTypes,
+		"attributes": dataSchema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Attributes of sqsCluster and spConnectCluster clusters.",
+			Attributes: map[string]dataSchema.Attribute{
+				"queue": dataSchema.SingleNestedAttribute{
+					Computed:    true,
+					Description: "Queue of the cluster.",
+					Attributes: map[string]dataSchema.Attribute{
+						"name": dataSchema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the queue.",
+						},
+						"region": dataSchema.StringAttribute{
+							Computed:    true,
+							Description: "AWS region of the queue.",
+						},
+					},
+				},
+				"key_store": dataSchema.StringAttribute{
+					Computed:    true,
+					Description: "Keystore of spConnectCluster clusters.",
+				},
+			},
+		},
+		"redis": dataSchema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Redis configuration of the cluster.",
+			Attributes: map[string]dataSchema.Attribute{
+				"redis_host": dataSchema.StringAttribute{
+					Computed:    true,
+					Description: "Host of the Redis server.",
+				},
+				"redis_port": dataSchema.Int32Attribute{
+					Computed:    true,
+					Description: "Port of the Redis server.",
+				},
+			},
 		},
 		"description": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Description of the cluster.",
 		},
 		"client_type": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Type of the clients of the cluster, such as CCG or VA.",
 		},
 		"ccg_version": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Version of the CCG running on the cluster.",
 		},
 		"pinned_config": dataSchema.BoolAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Whether the configuration of the cluster is pinned, so its virtual appliances are not updated.",
 		},
 		"operational": dataSchema.BoolAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Whether the cluster is operational.",
 		},
 		"status": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Status of the cluster, such as CONFIGURING, FAILED, NO_CLIENTS, NORMAL or WARNING.",
 		},
 		"public_key_certificate": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Certificate of the public key of the cluster, in PEM format.",
 		},
 		"public_key_thumbprint": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Thumbprint of the public key of the cluster.",
 		},
 		"public_key": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Public key of the cluster, in PEM format.",
 		},
 		"alert_key": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Key used by the cluster to authenticate its alerts.",
 		},
 		"client_ids": dataSchema.ListAttribute{
 			Computed:    true,
 			ElementType: types.StringType,
+			Description: "IDs of the virtual appliances of the cluster.",
 		},
 		"service_count": dataSchema.Int32Attribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Number of services of the cluster, such as the sources it connects to.",
 		},
 		"cc_id": dataSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "ID of the cluster in the legacy API.",
 		},
 		"created_at": dataSchema.StringAttribute{
-			Computed: true,
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the cluster.",
 		},
-		"encryption_configuration": dataSchema.ObjectAttribute{
-			Computed:       true,
-			AttributeTypes: managedClusterEncryptionConfigAttrTypes,
+		"encryption_configuration": dataSchema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Encryption settings of the cluster.",
+			Attributes: map[string]dataSchema.Attribute{
+				"format": dataSchema.StringAttribute{
+					Computed:    true,
+					Description: "Format of the data encrypted by the cluster, such as secrets, which determines how it is structured and processed.",
+				},
+			},
 		},
 	}
 	managedClusterResourceSchemaAttributes = map[string]resourceSchema.Attribute{
 		"id": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "ID of the cluster.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"name": resourceSchema.StringAttribute{
-			Required: true,
+			Required:    true,
+			Description: "Name of the cluster.",
 		},
 		"description": resourceSchema.StringAttribute{
-			Optional: true,
-			Computed: true, // API converts null to empty string
+			Optional:    true,
+			Description: "Description of the cluster.",
+			Computed:    true, // API converts null to empty string
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"type": resourceSchema.StringAttribute{
-			Optional: true,
+			Optional:    true,
+			Computed:    true, // API defaults to idn
+			Description: "Type of the cluster, defaults to idn. Changing it replaces the cluster.",
 			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 				stringplanmodifier.RequiresReplace(),
 			},
 		},
@@ -150,93 +241,155 @@ var (
 			Optional:    true,
 			Computed:    true,
 			ElementType: types.StringType,
+			Description: "Configuration of the cluster, such as its gmtOffset. Values which are not strings are JSON encoded.",
 			PlanModifiers: []planmodifier.Map{
 				mapplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"configuration_json": resourceSchema.StringAttribute{
+			CustomType:  normalizedJSONType{},
+			Computed:    true,
+			Description: "JSON encoded configuration of the cluster as returned by the API, keeping the values which are not strings. Those are JSON encoded in configuration.",
+		},
 		"pod": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Pod of the tenant the cluster belongs to.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"org": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Tenant the cluster belongs to.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
-		"key_pair": resourceSchema.ObjectAttribute{
-			Computed:       true,
-			AttributeTypes: managedClusterKeyPairAttrTypes,
+		"key_pair": resourceSchema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Key pair of the cluster, encrypting the credentials sent to its virtual appliances.",
+			Attributes: map[string]resourceSchema.Attribute{
+				"public_key": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "Public key of the cluster, in PEM format.",
+				},
+				"public_key_thumbprint": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "Thumbprint of the public key.",
+				},
+				"public_key_certificate": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "Certificate of the public key, in PEM format.",
+				},
+			},
 			PlanModifiers: []planmodifier.Object{
 				objectplanmodifier.UseStateForUnknown(),
 			},
 		},
-		"attributes": resourceSchema.ObjectAttribute{
-			Computed:       true,
-			AttributeTypes: managedClusterAttributesAttrTypes,
+		"attributes": resourceSchema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Attributes of sqsCluster and spConnectCluster clusters.",
+			Attributes: map[string]resourceSchema.Attribute{
+				"queue": resourceSchema.SingleNestedAttribute{
+					Computed:    true,
+					Description: "Queue of the cluster.",
+					Attributes: map[string]resourceSchema.Attribute{
+						"name": resourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the queue.",
+						},
+						"region": resourceSchema.StringAttribute{
+							Computed:    true,
+							Description: "AWS region of the queue.",
+						},
+					},
+				},
+				"key_store": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "Keystore of spConnectCluster clusters.",
+				},
+			},
 			PlanModifiers: []planmodifier.Object{
 				objectplanmodifier.UseStateForUnknown(),
 			},
 		},
-		"redis": resourceSchema.ObjectAttribute{
-			Computed:       true,
-			AttributeTypes: managedClusterRedisAttrTypes,
+		"redis": resourceSchema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Redis configuration of the cluster.",
+			Attributes: map[string]resourceSchema.Attribute{
+				"redis_host": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "Host of the Redis server.",
+				},
+				"redis_port": resourceSchema.Int32Attribute{
+					Computed:    true,
+					Description: "Port of the Redis server.",
+				},
+			},
 			PlanModifiers: []planmodifier.Object{
 				objectplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"client_type": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Type of the clients of the cluster, such as CCG or VA.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"ccg_version": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Version of the CCG running on the cluster.",
 			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
+				ignoreServerChangesModifier{},
 			},
 		},
 		"pinned_config": resourceSchema.BoolAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Whether the configuration of the cluster is pinned, so its virtual appliances are not updated.",
 			PlanModifiers: []planmodifier.Bool{
 				boolplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"operational": resourceSchema.BoolAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Whether the cluster is operational.",
 			PlanModifiers: []planmodifier.Bool{
-				boolplanmodifier.UseStateForUnknown(),
+				ignoreServerChangesModifier{},
 			},
 		},
 		"status": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Status of the cluster, such as CONFIGURING, FAILED, NO_CLIENTS, NORMAL or WARNING.",
 			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.UseStateForUnknown(),
+				ignoreServerChangesModifier{},
 			},
 		},
 		"public_key_certificate": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Certificate of the public key of the cluster, in PEM format.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"public_key_thumbprint": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Thumbprint of the public key of the cluster.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"public_key": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Public key of the cluster, in PEM format.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"alert_key": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Key used by the cluster to authenticate its alerts.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
@@ -244,66 +397,111 @@ var (
 		"client_ids": resourceSchema.ListAttribute{
 			Computed:    true,
 			ElementType: types.StringType,
+			Description: "IDs of the virtual appliances of the cluster.",
 			PlanModifiers: []planmodifier.List{
-				listplanmodifier.UseStateForUnknown(),
+				ignoreServerChangesModifier{},
 			},
 		},
 		"service_count": resourceSchema.Int32Attribute{
-			Computed: true,
+			Computed:    true,
+			Description: "Number of services of the cluster, such as the sources it connects to.",
 			PlanModifiers: []planmodifier.Int32{
-				int32planmodifier.UseStateForUnknown(),
+				ignoreServerChangesModifier{},
 			},
 		},
 		"cc_id": resourceSchema.StringAttribute{
-			Computed: true,
+			Computed:    true,
+			Description: "ID of the cluster in the legacy API.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"created_at": resourceSchema.StringAttribute{
-			Computed: true,
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the cluster.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
-		"encryption_configuration": resourceSchema.ObjectAttribute{
-			Computed:       true,
-			AttributeTypes: managedClusterEncryptionConfigAttrTypes,
+		"encryption_configuration": resourceSchema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Encryption settings of the cluster.",
+			Attributes: map[string]resourceSchema.Attribute{
+				"format": resourceSchema.StringAttribute{
+					Computed:    true,
+					Description: "Format of the data encrypted by the cluster, such as secrets, which determines how it is structured and processed.",
+				},
+			},
 			PlanModifiers: []planmodifier.Object{
 				objectplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"ignore_server_changes": ignoreServerChangesResourceSchemaAttribute,
+		"deletion_protection":   deletionProtectionResourceSchemaAttribute,
+		"wait_for_operational": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the creation waits for the cluster to report operational, within the create timeout, so objects depending on it are only created once it's ready. Defaults to false.",
+		},
+		"merge_configuration": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether configuration is merged into the configuration of the cluster: its keys are added or replaced, and keys removed from it are left on the cluster instead of being removed, so only a subset of the keys (ex. proxy settings) is managed without clobbering the keys SailPoint sets. Defaults to false.",
+		},
+		"fail_if_exists": resourceSchema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the creation fails when a cluster with the same name already exists, reporting how to import it instead, as when moving from a configuration which only read it with the managed cluster data sources. Clusters may share a name, so defaults to false.",
+		},
 	}
 )
 
 type managedClusterSourceModel struct {
-	ID                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	Pod                     types.String `tfsdk:"pod"`
-	Org                     types.String `tfsdk:"org"`
-	Type                    types.String `tfsdk:"type"`
-	Configuration           types.Map    `tfsdk:"configuration"`
-	KeyPair                 types.Object `tfsdk:"key_pair"`
-	Attributes              types.Object `tfsdk:"attributes"`
-	Redis                   types.Object `tfsdk:"redis"`
-	Description             types.String `tfsdk:"description"`
-	ClientType              types.String `tfsdk:"client_type"`
-	CcgVersion              types.String `tfsdk:"ccg_version"`
-	PinnedConfig            types.Bool   `tfsdk:"pinned_config"`
-	Operational             types.Bool   `tfsdk:"operational"`
-	Status                  types.String `tfsdk:"status"`
-	PublicKeyCertificate    types.String `tfsdk:"public_key_certificate"`
-	PublicKeyThumbprint     types.String `tfsdk:"public_key_thumbprint"`
-	PublicKey               types.String `tfsdk:"public_key"`
-	AlertKey                types.String `tfsdk:"alert_key"`
-	ClientIds               types.List   `tfsdk:"client_ids"`
-	ServiceCount            types.Int32  `tfsdk:"service_count"`
-	CcID                    types.String `tfsdk:"cc_id"`
-	CreatedAt               types.String `tfsdk:"created_at"`
-	EncryptionConfiguration types.Object `tfsdk:"encryption_configuration"`
+	ID                      types.String        `tfsdk:"id"`
+	Name                    types.String        `tfsdk:"name"`
+	Pod                     types.String        `tfsdk:"pod"`
+	Org                     types.String        `tfsdk:"org"`
+	Type                    types.String        `tfsdk:"type"`
+	Configuration           types.Map           `tfsdk:"configuration"`
+	ConfigurationJSON       normalizedJSONValue `tfsdk:"configuration_json"`
+	KeyPair                 types.Object        `tfsdk:"key_pair"`
+	Attributes              types.Object        `tfsdk:"attributes"`
+	Redis                   types.Object        `tfsdk:"redis"`
+	Description             types.String        `tfsdk:"description"`
+	ClientType              types.String        `tfsdk:"client_type"`
+	CcgVersion              types.String        `tfsdk:"ccg_version"`
+	PinnedConfig            types.Bool          `tfsdk:"pinned_config"`
+	Operational             types.Bool          `tfsdk:"operational"`
+	Status                  types.String        `tfsdk:"status"`
+	PublicKeyCertificate    types.String        `tfsdk:"public_key_certificate"`
+	PublicKeyThumbprint     types.String        `tfsdk:"public_key_thumbprint"`
+	PublicKey               types.String        `tfsdk:"public_key"`
+	AlertKey                types.String        `tfsdk:"alert_key"`
+	ClientIds               types.List          `tfsdk:"client_ids"`
+	ServiceCount            types.Int32         `tfsdk:"service_count"`
+	CcID                    types.String        `tfsdk:"cc_id"`
+	CreatedAt               rfc3339Value        `tfsdk:"created_at"`
+	EncryptionConfiguration types.Object        `tfsdk:"encryption_configuration"`
+}
+
+type managedClusterResourceModel struct {
+	managedClusterSourceModel
+	IgnoreServerChanges types.Bool     `tfsdk:"ignore_server_changes"`
+	DeletionProtection  types.Bool     `tfsdk:"deletion_protection"`
+	WaitForOperational  types.Bool     `tfsdk:"wait_for_operational"`
+	MergeConfiguration  types.Bool     `tfsdk:"merge_configuration"`
+	FailIfExists        types.Bool     `tfsdk:"fail_if_exists"`
+	Timeouts            *timeoutsModel `tfsdk:"timeouts"`
+}
+
+type managedClusterDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	managedClusterSourceModel
+	WaitForOperational types.Bool   `tfsdk:"wait_for_operational"`
+	WaitTimeout        types.String `tfsdk:"wait_timeout"`
 }
 
 type managedClustersDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
 	ManagedClusters []managedClusterSourceModel `tfsdk:"managed_clusters"`
 	Filters         types.String                `tfsdk:"filters"`
 }
@@ -324,8 +522,8 @@ type managedClusterAttributesQueueModel struct {
 }
 
 type managedClusterAttributesModel struct {
-	Queue    managedClusterAttributesQueueModel `tfsdk:"queue"`
-	KeyStore types.String                       `tfsdk:"key_store"`
+	Queue    *managedClusterAttributesQueueModel `tfsdk:"queue"`
+	KeyStore types.String                        `tfsdk:"key_store"`
 }
 
 type managedClusterRedisModel struct {
@@ -333,68 +531,224 @@ type managedClusterRedisModel struct {
 	RedisPort types.Int32  `tfsdk:"redis_port"`
 }
 
-func serializeManagedClusterData(ctx context.Context, cluster api_v2025.ManagedCluster) (managedClusterSourceModel, diag.Diagnostics) {
+// managedClusterDefaultWaitTimeout bounds the wait of the data source for a
+// cluster to become operational.
+const managedClusterDefaultWaitTimeout = 10 * time.Minute
 
-	configuration, diags := types.MapValueFrom(ctx, types.StringType, cluster.Configuration)
-	if diags != nil {
-		return managedClusterSourceModel{}, diags
+// managedClusterRead is a managed cluster read from the API along with its
+// configuration as returned, before its values were coerced to strings.
+type managedClusterRead struct {
+	Cluster       api_v2025.ManagedCluster
+	Configuration json.RawMessage
+}
+
+// getManagedCluster reads a managed cluster. The SDK maps its configuration
+// to a map of strings and fails to decode clusters with other values, those
+// are decoded again with their values JSON encoded.
+func getManagedCluster(ctx context.Context, client *sailpoint.APIClient, id string) (*managedClusterRead, *http.Response, error) {
+	cluster, res, err := client.V2025.ManagedClustersAPI.GetManagedCluster(ctx, id).Execute()
+	if res == nil || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, res, err
 	}
 
-	clientIds, diags := types.ListValueFrom(ctx, types.StringType, cluster.ClientIds)
-	if diags != nil {
-		return managedClusterSourceModel{}, diags
+	body, readErr := io.ReadAll(res.Body)
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return nil, res, errors.Join(err, readErr)
 	}
 
-	tflog.Trace(ctx, "Reading cluster configuration property", map[string]any{"configuration": cluster.Configuration})
+	var raw map[string]json.RawMessage
+	if jsonErr := json.Unmarshal(body, &raw); jsonErr != nil {
+		return nil, res, errors.Join(err, jsonErr)
+	}
+
+	if err != nil {
+		decoded, decodeErr := decodeManagedCluster(raw)
+		if decodeErr != nil {
+			return nil, res, err
+		}
+		cluster = decoded
+	}
+
+	return &managedClusterRead{Cluster: *cluster, Configuration: raw["configuration"]}, res, nil
+}
 
-	var createdAt string
-	createdAtDate, _ := cluster.GetCreatedAtOk()
+// managedClustersRequest lists managed clusters, decoding those the SDK
+// fails to decode as getManagedCluster does. Like the request it wraps, it
+// can be paginated.
+type managedClustersRequest struct {
+	request api_v2025.ApiGetManagedClustersRequest
+}
 
-	if createdAtDate != nil {
-		createdAt = createdAtDate.String()
-		tflog.Trace(ctx, "Reading cluster created at property string", map[string]any{"date": createdAtDate.String()})
+func (r managedClustersRequest) Count(count bool) managedClustersRequest {
+	return managedClustersRequest{request: r.request.Count(count)}
+}
+
+func (r managedClustersRequest) Limit(limit int32) managedClustersRequest {
+	return managedClustersRequest{request: r.request.Limit(limit)}
+}
+
+func (r managedClustersRequest) Offset(offset int32) managedClustersRequest {
+	return managedClustersRequest{request: r.request.Offset(offset)}
+}
+
+func (r managedClustersRequest) Execute() ([]managedClusterRead, *http.Response, error) {
+	clusters, res, err := r.request.Execute()
+	if res == nil || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, res, err
 	}
 
-	keyPairData := managedClusterKeyPairModel{
-		PublicKey:            types.StringPointerValue(extractNullableString(cluster.KeyPair.GetPublicKeyOk())),
-		PublicKeyThumbprint:  types.StringPointerValue(extractNullableString(cluster.KeyPair.GetPublicKeyThumbprintOk())),
-		PublicKeyCertificate: types.StringPointerValue(extractNullableString(cluster.KeyPair.GetPublicKeyCertificateOk())),
+	body, readErr := io.ReadAll(res.Body)
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return nil, res, errors.Join(err, readErr)
 	}
 
-	keyPairObject, diags := types.ObjectValueFrom(ctx, managedClusterKeyPairAttrTypes, keyPairData)
-	if diags != nil {
-		return managedClusterSourceModel{}, diags
+	var raw []map[string]json.RawMessage
+	if jsonErr := json.Unmarshal(body, &raw); jsonErr != nil {
+		return nil, res, errors.Join(err, jsonErr)
 	}
 
-	attributesData := managedClusterAttributesModel{
-		Queue: managedClusterAttributesQueueModel{
-			Name:   types.StringValue(cluster.GetAttributes().Queue.GetName()),
-			Region: types.StringValue(cluster.GetAttributes().Queue.GetRegion()),
-		},
-		KeyStore: types.StringPointerValue(extractNullableString(cluster.Attributes.GetKeystoreOk())),
+	reads := make([]managedClusterRead, len(raw))
+	for i, fields := range raw {
+		if err == nil && len(clusters) == len(raw) {
+			reads[i].Cluster = clusters[i]
+		} else {
+			decoded, decodeErr := decodeManagedCluster(fields)
+			if decodeErr != nil {
+				return nil, res, errors.Join(err, decodeErr)
+			}
+			reads[i].Cluster = *decoded
+		}
+		reads[i].Configuration = fields["configuration"]
+	}
+
+	return reads, res, nil
+}
+
+// waitForManagedClusterOperational polls the cluster read until it reports
+// operational, until ctx is done. The error then wraps the error of ctx. The
+// last read is returned on failure too.
+func waitForManagedClusterOperational(ctx context.Context, client *sailpoint.APIClient, read *managedClusterRead) (*managedClusterRead, *http.Response, error) {
+	if read.Cluster.GetOperational() {
+		return read, nil, nil
+	}
+
+	id := read.Cluster.GetId()
+	_, res, err := pollTask(ctx, "managed cluster "+id, func(ctx context.Context) (taskPoll, *http.Response, error) {
+		polled, res, err := getManagedCluster(ctx, client, id)
+		if err != nil {
+			return taskPoll{}, res, err
+		}
+		read = polled
+		return taskPoll{Status: read.Cluster.GetStatus(), Done: read.Cluster.GetOperational()}, res, nil
+	})
+	return read, res, err
+}
+
+// addManagedClusterWaitError adds the diagnostic of a failed wait for a
+// cluster to become operational.
+func addManagedClusterWaitError(ctx context.Context, diags *diag.Diagnostics, err error, res *http.Response) {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		diags.AddError("Managed Cluster Not Operational", err.Error())
+		return
+	}
+	addAPIError(ctx, diags, "Unable to Read Managed Cluster", err, res)
+}
+
+// decodeManagedCluster decodes the fields of a managed cluster, encoding the
+// values of its configuration which are not strings as JSON. Clusters without
+// a configuration are decoded as is.
+func decodeManagedCluster(raw map[string]json.RawMessage) (*api_v2025.ManagedCluster, error) {
+	var configuration map[string]json.RawMessage
+	if len(raw["configuration"]) > 0 {
+		if err := json.Unmarshal(raw["configuration"], &configuration); err != nil {
+			return nil, err
+		}
+	}
+
+	values := make(map[string]string, len(configuration))
+	for key, value := range configuration {
+		var text string
+		if err := json.Unmarshal(value, &text); err == nil {
+			values[key] = text
+			continue
+		}
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, value); err != nil {
+			return nil, err
+		}
+		values[key] = compacted.String()
 	}
 
-	attributesObject, diags := types.ObjectValueFrom(ctx, managedClusterAttributesAttrTypes, attributesData)
+	fields := make(map[string]json.RawMessage, len(raw))
+	for key, value := range raw {
+		fields[key] = value
+	}
+	if configuration != nil {
+		encoded, err := json.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+		fields["configuration"] = encoded
+	}
+
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var cluster api_v2025.ManagedCluster
+	if err := json.Unmarshal(body, &cluster); err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+// serializeManagedClusterData maps a cluster to its model. rawConfiguration is
+// the configuration as returned by the API, when the caller has access to it,
+// configuration_json encodes the SDK's map of strings otherwise.
+func serializeManagedClusterData(ctx context.Context, cluster api_v2025.ManagedCluster, rawConfiguration json.RawMessage) (managedClusterSourceModel, diag.Diagnostics) {
+
+	configuration, diags := types.MapValueFrom(ctx, types.StringType, cluster.Configuration)
 	if diags != nil {
 		return managedClusterSourceModel{}, diags
 	}
 
-	redisData := managedClusterRedisModel{
-		RedisHost: types.StringPointerValue(cluster.GetRedis().RedisHost),
-		RedisPort: types.Int32PointerValue(cluster.GetRedis().RedisPort),
+	clientIds, diags := types.ListValueFrom(ctx, types.StringType, cluster.ClientIds)
+	if diags != nil {
+		return managedClusterSourceModel{}, diags
 	}
 
-	redisObject, diags := types.ObjectValueFrom(ctx, managedClusterRedisAttrTypes, redisData)
-	if diags != nil {
+	tflog.Trace(ctx, "Reading cluster configuration property", map[string]any{"configuration": cluster.Configuration})
+
+	configurationJSON := normalizedJSONNull()
+	if len(rawConfiguration) == 0 || string(rawConfiguration) == "null" {
+		rawConfiguration, _ = json.Marshal(cluster.Configuration)
+	}
+	if string(rawConfiguration) != "null" {
+		configurationJSON = normalizedJSONStringValue(string(rawConfiguration))
+	}
+
+	createdAt, _ := cluster.GetCreatedAtOk()
+
+	keyPairObject, diags := serializeManagedClusterKeyPair(ctx, cluster.KeyPair)
+	if diags.HasError() {
 		return managedClusterSourceModel{}, diags
 	}
 
-	encryptionConfigData := managedClusterEncyprionConfigurationModel{
-		Format: types.StringPointerValue(cluster.GetEncryptionConfiguration().Format),
+	attributesObject, diags := serializeManagedClusterAttributes(ctx, cluster.Attributes)
+	if diags.HasError() {
+		return managedClusterSourceModel{}, diags
 	}
 
-	encryptionConfigObject, diags := types.ObjectValueFrom(ctx, managedClusterEncryptionConfigAttrTypes, encryptionConfigData)
-	if diags != nil {
+	redisObject, diags := serializeManagedClusterRedis(ctx, cluster.Redis)
+	if diags.HasError() {
+		return managedClusterSourceModel{}, diags
+	}
+
+	encryptionConfigObject, diags := serializeManagedClusterEncryptionConfig(ctx, cluster.EncryptionConfiguration)
+	if diags.HasError() {
 		return managedClusterSourceModel{}, diags
 	}
 
@@ -417,8 +771,9 @@ func serializeManagedClusterData(ctx context.Context, cluster api_v2025.ManagedC
 		ClientIds:               clientIds,
 		ServiceCount:            types.Int32Value(cluster.GetServiceCount()),
 		CcID:                    types.StringValue(cluster.GetCcId()),
-		CreatedAt:               types.StringPointerValue(&createdAt),
+		CreatedAt:               sailPointTimeValue(createdAt),
 		Configuration:           configuration,
+		ConfigurationJSON:       configurationJSON,
 		KeyPair:                 keyPairObject,
 		Attributes:              attributesObject,
 		Redis:                   redisObject,
@@ -426,3 +781,72 @@ func serializeManagedClusterData(ctx context.Context, cluster api_v2025.ManagedC
 	}
 	return obj, diags
 }
+
+// serializeManagedClusterKeyPair returns a null object when the API omitted
+// the key pair, as it does for clusters without a VA.
+func serializeManagedClusterKeyPair(ctx context.Context, keyPair *api_v2025.ManagedClusterKeyPair) (types.Object, diag.Diagnostics) {
+	if keyPair == nil {
+		return types.ObjectNull(managedClusterKeyPairAttrTypes), nil
+	}
+
+	return types.ObjectValueFrom(ctx, managedClusterKeyPairAttrTypes, managedClusterKeyPairModel{
+		PublicKey:            types.StringPointerValue(keyPair.PublicKey.Get()),
+		PublicKeyThumbprint:  types.StringPointerValue(keyPair.PublicKeyThumbprint.Get()),
+		PublicKeyCertificate: types.StringPointerValue(keyPair.PublicKeyCertificate.Get()),
+	})
+}
+
+// serializeManagedClusterAttributes returns a null object when the API omitted
+// the attributes, and a null queue when they have none.
+func serializeManagedClusterAttributes(ctx context.Context, attributes *api_v2025.ManagedClusterAttributes) (types.Object, diag.Diagnostics) {
+	if attributes == nil {
+		return types.ObjectNull(managedClusterAttributesAttrTypes), nil
+	}
+
+	data := managedClusterAttributesModel{
+		KeyStore: types.StringPointerValue(attributes.Keystore.Get()),
+	}
+	if queue := attributes.Queue; queue != nil {
+		data.Queue = &managedClusterAttributesQueueModel{
+			Name:   types.StringPointerValue(queue.Name),
+			Region: types.StringPointerValue(queue.Region),
+		}
+	}
+
+	return types.ObjectValueFrom(ctx, managedClusterAttributesAttrTypes, data)
+}
+
+// serializeManagedClusterRedis returns a null object when the API omitted the
+// redis configuration.
+func serializeManagedClusterRedis(ctx context.Context, redis *api_v2025.ManagedClusterRedis) (types.Object, diag.Diagnostics) {
+	if redis == nil {
+		return types.ObjectNull(managedClusterRedisAttrTypes), nil
+	}
+
+	return types.ObjectValueFrom(ctx, managedClusterRedisAttrTypes, managedClusterRedisModel{
+		RedisHost: types.StringPointerValue(redis.RedisHost),
+		RedisPort: types.Int32PointerValue(redis.RedisPort),
+	})
+}
+
+// serializeManagedClusterEncryptionConfig returns a null object when the API
+// omitted the encryption configuration.
+func serializeManagedClusterEncryptionConfig(ctx context.Context, config *api_v2025.ManagedClusterEncryptionConfig) (types.Object, diag.Diagnostics) {
+	if config == nil {
+		return types.ObjectNull(managedClusterEncryptionConfigAttrTypes), nil
+	}
+
+	return types.ObjectValueFrom(ctx, managedClusterEncryptionConfigAttrTypes, managedClusterEncyprionConfigurationModel{
+		Format: types.StringPointerValue(config.Format),
+	})
+}
+
+// keepServerManagedFields restores the attributes of a cluster the tenant
+// updates on its own to their prior values, for ignore_server_changes.
+func keepServerManagedFields(state *managedClusterSourceModel, prior managedClusterSourceModel) {
+	state.CcgVersion = prior.CcgVersion
+	state.Operational = prior.Operational
+	state.Status = prior.Status
+	state.ServiceCount = prior.ServiceCount
+	state.ClientIds = prior.ClientIds
+}
@@ -3,12 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
+	"maps"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
 )
 
 var (
@@ -21,7 +22,7 @@ func NewManagedClusterDataSource() datasource.DataSource {
 }
 
 type managedClusterDataSource struct {
-	client *sailpoint.APIClient
+	data *providerData
 }
 
 func (d *managedClusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -29,8 +30,23 @@ func (d *managedClusterDataSource) Metadata(_ context.Context, req datasource.Me
 }
 
 func (d *managedClusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+		"wait_for_operational": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether to wait for the cluster to report operational before returning, so objects depending on it are only created once it's ready. Defaults to false.",
+		},
+		"wait_timeout": schema.StringAttribute{
+			Optional:    true,
+			Validators:  []validator.String{durationValidator{}},
+			Description: "How long to wait for the cluster to become operational, as a Go duration string (defaults to 10m).",
+		},
+	}
+	maps.Copy(attributes, managedClusterDataSourceSchemaAttributes)
+
 	resp.Schema = schema.Schema{
-		Attributes: managedClusterDataSourceSchemaAttributes,
+		Description: "Reads a managed cluster, the group of virtual appliances connecting sources to the tenant.",
+		Attributes:  attributes,
 	}
 }
 
@@ -43,25 +59,31 @@ func (d *managedClusterDataSource) Configure(ctx context.Context, req datasource
 
 	tflog.Info(ctx, "Configuring SailPoint ManagedCluster data resource")
 
-	client, ok := req.ProviderData.(*sailpoint.APIClient)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sailpoint.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.data = data
 }
 
 func (d *managedClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Info(ctx, "Reading Managed Cluster")
-	var state managedClusterSourceModel
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state managedClusterDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := state.ID.ValueString()
 	if id == "" {
 		resp.Diagnostics.AddError(
@@ -72,24 +94,32 @@ func (d *managedClusterDataSource) Read(ctx context.Context, req datasource.Read
 	}
 	tflog.Debug(ctx, "Reading Managed Cluster filters", map[string]any{"id": id})
 
-	cluster, res, err := d.client.V2025.ManagedClustersAPI.GetManagedCluster(ctx, id).Execute()
+	cluster, res, err := cachedRead(d.data.cache, cacheKey(client, "managed-clusters/"+id), func() (*managedClusterRead, *http.Response, error) {
+		return getManagedCluster(ctx, client, id)
+	})
 
 	if err != nil {
-		defer res.Body.Close()
-		bodyBytes, _ := io.ReadAll(res.Body)
-		tflog.Error(ctx, "Error reading managed cluster", map[string]any{"error": err.Error(), "response_body": bodyBytes})
-		resp.Diagnostics.AddError(
-			"Unable to Read Managed Cluster",
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Managed Cluster", err, res)
 		return
 	}
 
-	state, diags := serializeManagedClusterData(ctx, *cluster)
+	if state.WaitForOperational.ValueBool() {
+		waitCtx, cancel := context.WithTimeout(ctx, timeoutValue(state.WaitTimeout, managedClusterDefaultWaitTimeout))
+		defer cancel()
+
+		cluster, res, err = waitForManagedClusterOperational(waitCtx, client, cluster)
+		if err != nil {
+			addManagedClusterWaitError(ctx, &resp.Diagnostics, err, res)
+			return
+		}
+	}
+
+	clusterState, diags := serializeManagedClusterData(ctx, cluster.Cluster, cluster.Configuration)
 	if diags != nil {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	state.managedClusterSourceModel = clusterState
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &managedClusterListResource{}
+	_ list.ListResourceWithConfigure = &managedClusterListResource{}
+)
+
+func NewManagedClusterListResource() list.ListResource {
+	return &managedClusterListResource{}
+}
+
+// managedClusterListResource lists the managed clusters of the tenant for
+// terraform query (Terraform 1.14 and later), which can generate the import
+// blocks and configuration of the clusters not managed yet.
+type managedClusterListResource struct {
+	data *providerData
+}
+
+type managedClusterListResourceModel struct {
+	Filters types.String `tfsdk:"filters"`
+}
+
+func (l *managedClusterListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_cluster"
+}
+
+func (l *managedClusterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the managed clusters of the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter of the clusters listed, using the standard syntax described in V3 API Standard Collection Parameters (ex. name sw \"Prod\").",
+			},
+		},
+	}
+}
+
+func (l *managedClusterListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ManagedCluster list resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.data = data
+}
+
+func (l *managedClusterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, "Listing Managed Clusters")
+
+	var (
+		config managedClusterListResourceModel
+		diags  diag.Diagnostics
+	)
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// A limit of 0 lists all the clusters.
+	pagination := paginationModel{MaxResults: types.Int64Value(req.Limit)}
+	request := managedClustersRequest{request: l.data.client().V2025.ManagedClustersAPI.GetManagedClusters(ctx).Filters(config.Filters.ValueString())}
+	clusters, res, err := paginate[managedClusterRead](request, &pagination)
+	l.data.rateLimit.warnRateLimit(&diags)
+	if err != nil {
+		addAPIError(ctx, &diags, "Unable to List Managed Clusters", err, res)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, read := range clusters {
+			cluster := read.Cluster
+			result := req.NewListResult(ctx)
+			result.DisplayName = cluster.GetName()
+			if i == 0 {
+				// Rate limit warnings
+				result.Diagnostics.Append(diags...)
+			}
+			setIDIdentity(ctx, result.Identity, types.StringValue(cluster.GetId()), &result.Diagnostics)
+
+			if req.IncludeResource {
+				// As when imported, the whole configuration of the cluster
+				// is kept.
+				var state managedClusterResourceModel
+				var serializeDiags diag.Diagnostics
+				state.managedClusterSourceModel, serializeDiags = serializeManagedClusterData(ctx, cluster, read.Configuration)
+				result.Diagnostics.Append(serializeDiags...)
+				if !serializeDiags.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -2,13 +2,12 @@ package provider
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
-	"io"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,11 +18,19 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &managedClusterResource{}
-	_ resource.ResourceWithConfigure   = &managedClusterResource{}
-	_ resource.ResourceWithImportState = &managedClusterResource{}
+	_ resource.Resource                 = &managedClusterResource{}
+	_ resource.ResourceWithConfigure    = &managedClusterResource{}
+	_ resource.ResourceWithImportState  = &managedClusterResource{}
+	_ resource.ResourceWithIdentity     = &managedClusterResource{}
+	_ resource.ResourceWithUpgradeState = &managedClusterResource{}
 )
 
+var managedClusterStateUpgrades []stateUpgrade
+
+// managedClusterDefaultTimeout bounds each operation on a managed cluster
+// unless the timeouts block overrides it.
+const managedClusterDefaultTimeout = 20 * time.Minute
+
 // NewManagedClusterResource is a helper function to simplify the provider implementation.
 func NewManagedClusterResource() resource.Resource {
 	return &managedClusterResource{}
@@ -31,7 +38,9 @@ func NewManagedClusterResource() resource.Resource {
 
 // managedClusterResource is the resource implementation.
 type managedClusterResource struct {
-	client *sailpoint.APIClient
+	client                 *sailpoint.APIClient
+	readAfterCreateTimeout time.Duration
+	rateLimit              *rateLimitUsage
 }
 
 // Metadata returns the resource type name.
@@ -42,10 +51,23 @@ func (r *managedClusterResource) Metadata(_ context.Context, req resource.Metada
 // Schema defines the schema for the resource.
 func (r *managedClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes: managedClusterResourceSchemaAttributes,
+		Version:     stateVersion(managedClusterStateUpgrades),
+		Description: "Manages a managed cluster, the group of virtual appliances connecting sources to the tenant. The virtual appliances themselves are deployed and paired outside of Terraform.",
+		Attributes:  managedClusterResourceSchemaAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsResourceSchemaBlock,
+		},
 	}
 }
 
+func (r *managedClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
+func (r *managedClusterResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(managedClusterStateUpgrades)
+}
+
 func (r *managedClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
 	// sets that data after it calls the ConfigureProvider RPC.
@@ -55,31 +77,37 @@ func (r *managedClusterResource) Configure(ctx context.Context, req resource.Con
 
 	tflog.Info(ctx, "Configuring SailPoint ManagedCluster resource")
 
-	client, ok := req.ProviderData.(*sailpoint.APIClient)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sailpoint.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client()
+	r.readAfterCreateTimeout = data.readAfterCreateTimeout
+	r.rateLimit = data.rateLimit
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *managedClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Info(ctx, "creating managed cluster resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
 
 	// Retrieve values from plan
-	var plan managedClusterSourceModel
+	var plan managedClusterResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.create(managedClusterDefaultTimeout))
+	defer cancel()
+
 	var configuration *map[string]string
 	configuration = &map[string]string{}
 	for k, v := range plan.Configuration.Elements() {
@@ -105,58 +133,66 @@ func (r *managedClusterResource) Create(ctx context.Context, req resource.Create
 	// Generate API request body from plan
 	managedCluster := api_v2025.ManagedClusterRequest{
 		Name:          plan.Name.ValueString(),
-		Type:          (*api_v2025.ManagedClusterTypes)(plan.Type.ValueStringPointer()),
 		Description:   description,
 		Configuration: configuration,
 	}
-	tflog.Info(ctx, "Creating managed cluster with the values", map[string]any{"cluster": managedCluster})
+	if !plan.Type.IsUnknown() {
+		managedCluster.Type = (*api_v2025.ManagedClusterTypes)(plan.Type.ValueStringPointer())
+	}
+	tflog.Info(ctx, "Creating managed cluster", map[string]any{"name": managedCluster.Name, "type": plan.Type.ValueString()})
+
+	// Clusters may share a name, a cluster already named so is only reported
+	// when asked to, explaining how to import it instead of creating a
+	// duplicate.
+	if plan.FailIfExists.ValueBool() {
+		ids, res, err := r.clusterIDsNamed(ctx, managedCluster.Name)
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, "unable to list Managed Clusters", err, res)
+			return
+		}
+		if len(ids) > 0 {
+			addAlreadyExistsError(&resp.Diagnostics, "sailpoint_managed_cluster", "managed cluster", managedCluster.Name, ids)
+			return
+		}
+	}
 
 	// Create new cluster
 	cluster, res, err := r.client.V2025.ManagedClustersAPI.CreateManagedCluster(ctx).ManagedClusterRequest(managedCluster).Execute()
 
 	if err != nil {
-		if res != nil && res.Body != nil {
-			defer res.Body.Close()
-			bodyBytes, _ := io.ReadAll(res.Body)
-			decodedBytes, _ := base64.StdEncoding.DecodeString(string(bodyBytes))
-			tflog.Error(ctx, "error creating cluster", map[string]any{"error": err.Error(), "response_body": decodedBytes})
-			if decodedBytes != nil && string(decodedBytes) != "" {
-				resp.Diagnostics.AddError(
-					"unable to create Managed Cluster",
-					string(decodedBytes),
-				)
-			} else {
-				resp.Diagnostics.AddError(
-					"unable to create Managed Cluster",
-					string(bodyBytes),
-				)
-			}
-		}
-		resp.Diagnostics.AddError(
-			"unable to create Managed Cluster",
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, "unable to create Managed Cluster", err, res)
 		return
 	}
 
 	// Get refreshed managed cluster value from Sailpoint API
-	cluster, res, err = r.client.V2025.ManagedClustersAPI.GetManagedCluster(ctx, cluster.Id).Execute()
+	read, res, err := readAfterCreate(ctx, r.readAfterCreateTimeout, func(ctx context.Context) (*managedClusterRead, *http.Response, error) {
+		return getManagedCluster(ctx, r.client, cluster.Id)
+	})
 
 	if err != nil {
-		if res != nil && res.Body != nil {
-			defer res.Body.Close()
-			bodyBytes, _ := io.ReadAll(res.Body)
-			tflog.Error(ctx, "error reading cluster resource", map[string]any{"error": err.Error(), "response_body": bodyBytes})
-		}
-		resp.Diagnostics.AddError(
-			"unable to read Managed Cluster resource",
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, "unable to read Managed Cluster resource", err, res)
 		return
 	}
 
+	// The cluster is saved to the state, and tainted, even when it doesn't
+	// become operational so it isn't left behind.
+	if plan.WaitForOperational.ValueBool() {
+		read, res, err = waitForManagedClusterOperational(ctx, r.client, read)
+		if err != nil {
+			addManagedClusterWaitError(ctx, &resp.Diagnostics, err, res)
+		}
+	}
+
 	// Map response body to schema and populate Computed attribute values
-	state, diags := serializeManagedClusterData(ctx, *cluster)
+	state := managedClusterResourceModel{
+		IgnoreServerChanges: plan.IgnoreServerChanges,
+		DeletionProtection:  plan.DeletionProtection,
+		WaitForOperational:  plan.WaitForOperational,
+		MergeConfiguration:  plan.MergeConfiguration,
+		FailIfExists:        plan.FailIfExists,
+		Timeouts:            plan.Timeouts,
+	}
+	state.managedClusterSourceModel, diags = serializeManagedClusterData(ctx, read.Cluster, read.Configuration)
 	if diags != nil {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -180,6 +216,7 @@ func (r *managedClusterResource) Create(ctx context.Context, req resource.Create
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIDIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -190,50 +227,60 @@ func (r *managedClusterResource) Create(ctx context.Context, req resource.Create
 // Read refreshes the Terraform state with the latest data.
 func (r *managedClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Info(ctx, "reading managed cluster resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
 	// Get current state
-	var state managedClusterSourceModel
+	var state managedClusterResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, state.Timeouts.read(managedClusterDefaultTimeout))
+	defer cancel()
+
 	stateConfig := make(map[string]string)
 	state.Configuration.ElementsAs(ctx, &stateConfig, false)
+	stateConfigNull := state.Configuration.IsNull()
 
 	// Get refreshed managed cluster value from Sailpoint API
-	cluster, res, err := r.client.V2025.ManagedClustersAPI.GetManagedCluster(ctx, state.ID.ValueString()).Execute()
-
+	read, res, err := getManagedCluster(ctx, r.client, state.ID.ValueString())
+	if res != nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		if res != nil && res.Body != nil {
-			defer res.Body.Close()
-			bodyBytes, _ := io.ReadAll(res.Body)
-			tflog.Error(ctx, "error reading cluster resource", map[string]any{"error": err.Error(), "response_body": bodyBytes})
-		}
-		resp.Diagnostics.AddError(
-			"unable to read Managed Cluster resource",
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, "unable to read Managed Cluster resource", err, res)
 		return
 	}
+	cluster := read.Cluster
 
-	state, diags = serializeManagedClusterData(ctx, *cluster)
+	prior := state.managedClusterSourceModel
+	state.managedClusterSourceModel, diags = serializeManagedClusterData(ctx, cluster, read.Configuration)
+	if state.IgnoreServerChanges.ValueBool() {
+		keepServerManagedFields(&state.managedClusterSourceModel, prior)
+	}
 
-	filteredConfig := make(map[string]string)
-	for k, v := range *cluster.Configuration {
-		// If it's in the state keep it
-		if _, exists := stateConfig[k]; exists {
-			filteredConfig[k] = v
+	// A null configuration means the cluster was just imported, all of its
+	// configuration is then kept since none of it is known to be managed.
+	if !stateConfigNull {
+		filteredConfig := make(map[string]string)
+		for k, v := range cluster.GetConfiguration() {
+			// If it's in the state keep it
+			if _, exists := stateConfig[k]; exists {
+				filteredConfig[k] = v
+			}
 		}
-	}
 
-	tflog.Debug(ctx, "Managed cluster filtered configuration: ", map[string]any{"filteredConfig": filteredConfig, "stateConfig": stateConfig})
+		tflog.Debug(ctx, "Managed cluster filtered configuration: ", map[string]any{"filteredConfig": filteredConfig, "stateConfig": stateConfig})
 
-	state.Configuration, _ = types.MapValueFrom(ctx, types.StringType, filteredConfig)
+		state.Configuration, _ = types.MapValueFrom(ctx, types.StringType, filteredConfig)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIDIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -241,7 +288,10 @@ func (r *managedClusterResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Info(ctx, "finish reading managed cluster resource")
 }
 
-func buildConfigurationPatchOps(ctx context.Context, newConfig map[string]attr.Value, oldConfig map[string]attr.Value) ([]api_v2025.JsonPatchOperation, error) {
+// buildConfigurationPatchOps builds the operations updating the configuration
+// of a cluster from oldConfig to newConfig. With merge, the keys of oldConfig
+// missing from newConfig are kept on the cluster.
+func buildConfigurationPatchOps(ctx context.Context, newConfig map[string]attr.Value, oldConfig map[string]attr.Value, merge bool) ([]api_v2025.JsonPatchOperation, error) {
 	tflog.Debug(ctx, "building configuration block", map[string]any{"newConfig": newConfig, "oldConfig": oldConfig})
 	ops := make([]api_v2025.JsonPatchOperation, 0)
 
@@ -268,6 +318,9 @@ func buildConfigurationPatchOps(ctx context.Context, newConfig map[string]attr.V
 		ops = append(ops, *op)
 	}
 
+	if merge {
+		return ops, nil
+	}
 	for k := range oldConfig {
 		exists := newConfig[k] != nil
 		tflog.Debug(ctx, "building configuration block for old config", map[string]any{"k": k, "exists": exists})
@@ -283,10 +336,11 @@ func buildConfigurationPatchOps(ctx context.Context, newConfig map[string]attr.V
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *managedClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	tflog.Info(ctx, "updating managed cluster resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
 
 	var (
-		plan  managedClusterSourceModel
-		state managedClusterSourceModel
+		plan  managedClusterResourceModel
+		state managedClusterResourceModel
 	)
 
 	diags := req.State.Get(ctx, &state)
@@ -301,6 +355,9 @@ func (r *managedClusterResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.update(managedClusterDefaultTimeout))
+	defer cancel()
+
 	tflog.Info(ctx, "updating managed cluster resource with ID", map[string]any{"id": plan.ID.ValueString()})
 
 	jpOps := api_v2025.NewJsonPatch()
@@ -317,7 +374,7 @@ func (r *managedClusterResource) Update(ctx context.Context, req resource.Update
 		operations = append(operations, *op)
 	}
 	if !plan.Configuration.IsUnknown() && !plan.Configuration.Equal(state.Configuration) {
-		ops, err := buildConfigurationPatchOps(ctx, plan.Configuration.Elements(), state.Configuration.Elements())
+		ops, err := buildConfigurationPatchOps(ctx, plan.Configuration.Elements(), state.Configuration.Elements(), plan.MergeConfiguration.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"unable to update Managed Cluster",
@@ -335,37 +392,32 @@ func (r *managedClusterResource) Update(ctx context.Context, req resource.Update
 	cluster, res, err := r.client.V2025.ManagedClustersAPI.UpdateManagedCluster(ctx, plan.ID.ValueString()).JsonPatchOperation(jpOps.GetOperations()).Execute()
 
 	if err != nil {
-		if res != nil && res.Body != nil {
-			defer res.Body.Close()
-			bodyBytes, _ := io.ReadAll(res.Body)
-			tflog.Error(ctx, "error updating cluster", map[string]any{"error": err.Error(), "response_body": bodyBytes})
-		}
-		resp.Diagnostics.AddError(
-			"unable to update Managed Cluster",
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, "unable to update Managed Cluster", err, res)
 		return
 	}
 
 	// Get refreshed managed cluster value from Sailpoint API
-	cluster, res, err = r.client.V2025.ManagedClustersAPI.GetManagedCluster(ctx, plan.ID.ValueString()).Execute()
+	read, res, err := getManagedCluster(ctx, r.client, plan.ID.ValueString())
 
 	if err != nil {
-		if res != nil && res.Body != nil {
-			defer res.Body.Close()
-			bodyBytes, _ := io.ReadAll(res.Body)
-			tflog.Error(ctx, "error reading cluster resource", map[string]any{"error": err.Error(), "response_body": bodyBytes})
-		}
-		resp.Diagnostics.AddError(
-			"unable to read Managed Cluster resource",
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, "unable to read Managed Cluster resource", err, res)
 		return
 	}
+	cluster = &read.Cluster
 
 	// Map response body to schema and populate Computed attribute values
-	tflog.Debug(ctx, "serializing cluster updated data", map[string]any{"cluster": cluster})
-	state, diags = serializeManagedClusterData(ctx, *cluster)
+	tflog.Debug(ctx, "serializing cluster updated data", map[string]any{"id": cluster.Id})
+	prior := state.managedClusterSourceModel
+	state.managedClusterSourceModel, diags = serializeManagedClusterData(ctx, read.Cluster, read.Configuration)
+	if plan.IgnoreServerChanges.ValueBool() {
+		keepServerManagedFields(&state.managedClusterSourceModel, prior)
+	}
+	state.IgnoreServerChanges = plan.IgnoreServerChanges
+	state.DeletionProtection = plan.DeletionProtection
+	state.WaitForOperational = plan.WaitForOperational
+	state.MergeConfiguration = plan.MergeConfiguration
+	state.FailIfExists = plan.FailIfExists
+	state.Timeouts = plan.Timeouts
 	if diags != nil {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -390,10 +442,11 @@ func (r *managedClusterResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	tflog.Debug(ctx, "persisting state with the values", map[string]any{"state": state, "configuration": state.Configuration})
+	tflog.Debug(ctx, "persisting state", map[string]any{"id": state.ID.ValueString(), "name": state.Name.ValueString()})
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	setIDIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -404,35 +457,50 @@ func (r *managedClusterResource) Update(ctx context.Context, req resource.Update
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *managedClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "deleting managed cluster resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
 
-	var state managedClusterSourceModel
+	var state managedClusterResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if deletionProtected(state.DeletionProtection, "managed cluster", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, state.Timeouts.delete(managedClusterDefaultTimeout))
+	defer cancel()
+
 	tflog.Info(ctx, "deleting managed cluster resource with ID", map[string]any{"id": state.ID.ValueString()})
 
 	res, err := r.client.V2025.ManagedClustersAPI.DeleteManagedCluster(ctx, state.ID.ValueString()).Execute()
 
 	if err != nil {
-		if res != nil && res.Body != nil {
-			defer res.Body.Close()
-			bodyBytes, _ := io.ReadAll(res.Body)
-			tflog.Error(ctx, "error deleting cluster resource", map[string]any{"error": err.Error(), "response_body": bodyBytes})
-		}
-		resp.Diagnostics.AddError(
-			"unable to delete Managed Cluster resource",
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, "unable to delete Managed Cluster resource", err, res)
 		return
 	}
 
 	tflog.Info(ctx, "finish deleting managed cluster resource")
 }
 
+// ImportState imports a cluster by ID, by name, as name:<name>, or by
+// identity.
 func (r *managedClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByIDOrName(ctx, req, resp, "managed cluster", r.clusterIDsNamed)
+}
+
+// clusterIDsNamed returns the IDs of the clusters with the given name.
+func (r *managedClusterResource) clusterIDsNamed(ctx context.Context, name string) ([]string, *http.Response, error) {
+	request := managedClustersRequest{request: r.client.V2025.ManagedClustersAPI.GetManagedClusters(ctx).Filters("name eq " + quoteFilterString(name))}
+	clusters, res, err := request.Execute()
+	ids := make([]string, 0, len(clusters))
+	for _, read := range clusters {
+		// eq ignores the case of names
+		if read.Cluster.GetName() == name {
+			ids = append(ids, read.Cluster.Id)
+		}
+	}
+	return ids, res, err
 }
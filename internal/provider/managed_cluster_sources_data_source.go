@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &managedClusterSourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &managedClusterSourcesDataSource{}
+)
+
+func NewManagedClusterSourcesDataSource() datasource.DataSource {
+	return &managedClusterSourcesDataSource{}
+}
+
+type managedClusterSourcesDataSource struct {
+	data *providerData
+}
+
+type managedClusterSourcesDataSourceModel struct {
+	APIVersion types.String              `tfsdk:"api_version"`
+	ClusterID  types.String              `tfsdk:"cluster_id"`
+	Filters    types.String              `tfsdk:"filters"`
+	Sources    []managedClusterSourceRef `tfsdk:"sources"`
+}
+
+type managedClusterSourceRef struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	ConnectorName types.String `tfsdk:"connector_name"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	Healthy       types.Bool   `tfsdk:"healthy"`
+	Status        types.String `tfsdk:"status"`
+}
+
+func (d *managedClusterSourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_cluster_sources"
+}
+
+func (d *managedClusterSourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the sources attached to a managed cluster, to assess which sources a maintenance of the cluster affects. The sources API can't filter by cluster, so the sources are listed, narrowed on the server by filters when set, and those of the cluster kept.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the managed cluster.",
+			},
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter narrowing the sources listed by the API before those of the cluster are kept, using the standard syntax described in V3 API Standard Collection Parameters (ex. connectorName eq \"Active Directory\"), so tenants with many sources aren't listed in full.",
+			},
+			"sources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Sources attached to the cluster, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the source.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the source.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the source's connector.",
+						},
+						"connector_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the connector of the source (ex. Active Directory).",
+						},
+						"authoritative": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the source is authoritative, its accounts creating identities.",
+						},
+						"healthy": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the source is healthy.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the source, which explains why it is or isn't healthy (ex. SOURCE_STATE_HEALTHY).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *managedClusterSourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ManagedClusterSources data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *managedClusterSourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Managed Cluster Sources")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state managedClusterSourcesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unknown cluster is an error rather than a cluster without sources.
+	clusterID := state.ClusterID.ValueString()
+	_, res, err := getManagedCluster(ctx, client, clusterID)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Managed Cluster", err, res)
+		return
+	}
+
+	request := client.V2025.SourcesAPI.ListSources(ctx)
+	if !state.Filters.IsNull() {
+		request = request.Filters(state.Filters.ValueString())
+	}
+	sources, res, err := paginate[api_v2025.Source](request, &paginationModel{MaxResults: types.Int64Value(0)})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Sources", err, res)
+		return
+	}
+	state.Sources = sourcesOnCluster(sources, clusterID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// sourcesOnCluster returns the sources attached to the cluster, sorted by
+// name.
+func sourcesOnCluster(sources []api_v2025.Source, clusterID string) []managedClusterSourceRef {
+	refs := make([]managedClusterSourceRef, 0)
+	for _, source := range sources {
+		if source.GetCluster().Id != clusterID {
+			continue
+		}
+		refs = append(refs, managedClusterSourceRef{
+			ID:            types.StringPointerValue(source.Id),
+			Name:          types.StringValue(source.Name),
+			Type:          types.StringPointerValue(source.Type),
+			ConnectorName: types.StringPointerValue(source.ConnectorName),
+			Authoritative: types.BoolPointerValue(source.Authoritative),
+			Healthy:       types.BoolPointerValue(source.Healthy),
+			Status:        types.StringPointerValue(source.Status),
+		})
+	}
+	slices.SortFunc(refs, func(a, b managedClusterSourceRef) int {
+		return cmp.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+	return refs
+}
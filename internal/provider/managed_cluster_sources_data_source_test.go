@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestSourcesOnCluster(t *testing.T) {
+	onCluster := func(name string, clusterID string) api_v2025.Source {
+		source := api_v2025.Source{Name: name}
+		if clusterID != "" {
+			source.Cluster = *api_v2025.NewNullableSourceCluster(&api_v2025.SourceCluster{Type: "CLUSTER", Id: clusterID})
+		}
+		return source
+	}
+	sources := []api_v2025.Source{onCluster("LDAP", "c1"), onCluster("HR", ""), onCluster("AD", "c1"), onCluster("Okta", "c2")}
+
+	refs := sourcesOnCluster(sources, "c1")
+	if len(refs) != 2 || refs[0].Name.ValueString() != "AD" || refs[1].Name.ValueString() != "LDAP" {
+		t.Errorf("unexpected sources %+v", refs)
+	}
+}
+
+func TestManagedClusterSourcesDataSourceRead(t *testing.T) {
+	clusterID := "e1ff7bb24c934240bbf55e1aa39e41c5"
+	fake := newFakeSailPoint(t)
+	// The SDK can't decode the boolean, the cluster is read anyway.
+	fake.respond(http.MethodGet, "/v2025/managed-clusters/"+clusterID, http.StatusOK, `{"id": "`+clusterID+`", "name": "Production", "clientType": "CCG", "ccgVersion": "v01", "configuration": {"debug": true}}`)
+	fake.handle(http.MethodGet, "/v2025/sources", func(w http.ResponseWriter, r *http.Request) {
+		if filters := r.URL.Query().Get("filters"); filters != `connectorName eq "Active Directory"` {
+			t.Errorf("unexpected filters %q", filters)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "s1", "name": "AD", "owner": {"type": "IDENTITY", "id": "o1"}, "connector": "active-directory", "cluster": {"type": "CLUSTER", "id": "` + clusterID + `", "name": "Production"}}, {"id": "s2", "name": "AD cloud", "owner": {"type": "IDENTITY", "id": "o1"}, "connector": "active-directory"}]`))
+	})
+
+	state, diags := readTestDataSource(t, NewManagedClusterSourcesDataSource(), fake.providerData(), map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, clusterID),
+		"filters":    tftypes.NewValue(tftypes.String, `connectorName eq "Active Directory"`),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var sources []managedClusterSourceRef
+	diags.Append(state.GetAttribute(t.Context(), path.Root("sources"), &sources)...)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(sources) != 1 || sources[0].Name.ValueString() != "AD" {
+		t.Errorf("unexpected sources %+v", sources)
+	}
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestDecodeManagedCluster(t *testing.T) {
+	var raw map[string]json.RawMessage
+	body := `{"id": "c1", "clientType": "CCG", "ccgVersion": "v1", "configuration": {"gmtOffset": "-5", "debug": true, "nested": {"a": [1, 2]}}}`
+	if err := json.Unmarshal([]byte(body), &raw); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster, err := decodeManagedCluster(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	configuration := cluster.GetConfiguration()
+	for key, expected := range map[string]string{"gmtOffset": "-5", "debug": "true", "nested": `{"a":[1,2]}`} {
+		if configuration[key] != expected {
+			t.Errorf("configuration %s: got %q, expected %q", key, configuration[key], expected)
+		}
+	}
+
+	state, diags := serializeManagedClusterData(context.Background(), *cluster, raw["configuration"])
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	equal, _ := state.ConfigurationJSON.StringSemanticEquals(context.Background(), normalizedJSONStringValue(`{"debug":true,"gmtOffset":"-5","nested":{"a":[1,2]}}`))
+	if !equal {
+		t.Errorf("unexpected configuration_json %s", state.ConfigurationJSON)
+	}
+}
+
+func TestSerializeManagedClusterDataSparse(t *testing.T) {
+	var cluster api_v2025.ManagedCluster
+	if err := json.Unmarshal([]byte(`{"id": "c1", "clientType": "CCG", "ccgVersion": "v1"}`), &cluster); err != nil {
+		t.Fatal(err)
+	}
+
+	state, diags := serializeManagedClusterData(context.Background(), cluster, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	for name, value := range map[string]attr.Value{
+		"key_pair":                 state.KeyPair,
+		"attributes":               state.Attributes,
+		"redis":                    state.Redis,
+		"encryption_configuration": state.EncryptionConfiguration,
+		"configuration":            state.Configuration,
+		"configuration_json":       state.ConfigurationJSON,
+		"created_at":               state.CreatedAt,
+	} {
+		if !value.IsNull() {
+			t.Errorf("expected %s to be null, got %s", name, value)
+		}
+	}
+}
+
+func TestSerializeManagedClusterDataPartialAttributes(t *testing.T) {
+	var cluster api_v2025.ManagedCluster
+	body := `{"id": "c1", "clientType": "CCG", "ccgVersion": "v1", "attributes": {"keystore": "ks"}, "keyPair": {"publicKey": "pk"}, "redis": {}}`
+	if err := json.Unmarshal([]byte(body), &cluster); err != nil {
+		t.Fatal(err)
+	}
+
+	state, diags := serializeManagedClusterData(context.Background(), cluster, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	attributes := state.Attributes.Attributes()
+	if !attributes["queue"].IsNull() || attributes["key_store"].String() != `"ks"` {
+		t.Errorf("unexpected attributes %s", state.Attributes)
+	}
+	keyPair := state.KeyPair.Attributes()
+	if keyPair["public_key"].String() != `"pk"` || !keyPair["public_key_certificate"].IsNull() {
+		t.Errorf("unexpected key_pair %s", state.KeyPair)
+	}
+	redis := state.Redis.Attributes()
+	if state.Redis.IsNull() || !redis["redis_host"].IsNull() || !redis["redis_port"].IsNull() {
+		t.Errorf("unexpected redis %s", state.Redis)
+	}
+}
+
+func TestManagedClusterListResourceList(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeSailPoint(t)
+	fake.respond(http.MethodGet, "/v2025/managed-clusters", http.StatusOK, `[
+		{"id": "e1ff7bb24c934240bbf55e1aa39e41c5", "name": "Production", "type": "idn", "clientType": "CCG", "ccgVersion": "v01", "configuration": {"gmtOffset": "-5", "debug": true}},
+		{"id": "f2aa7bb24c934240bbf55e1aa39e41c6", "name": "Staging", "type": "idn", "clientType": "CCG", "ccgVersion": "v01"}
+	]`)
+
+	l := NewManagedClusterListResource().(*managedClusterListResource)
+	var configureResp resource.ConfigureResponse
+	l.Configure(ctx, resource.ConfigureRequest{ProviderData: fake.providerData()}, &configureResp)
+
+	var schemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	var resourceSchemaResp resource.SchemaResponse
+	NewManagedClusterResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+
+	configType := schemaResp.Schema.Type().TerraformType(ctx)
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(configType, map[string]tftypes.Value{"filters": tftypes.NewValue(tftypes.String, nil)}),
+		},
+		IncludeResource:        true,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: idIdentitySchema,
+	}
+	var stream list.ListResultsStream
+	l.List(ctx, req, &stream)
+
+	var names []string
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatal(result.Diagnostics)
+		}
+		var identity idIdentityModel
+		result.Diagnostics.Append(result.Identity.Get(ctx, &identity)...)
+		var id types.String
+		result.Diagnostics.Append(result.Resource.GetAttribute(ctx, path.Root("id"), &id)...)
+		if result.Diagnostics.HasError() {
+			t.Fatal(result.Diagnostics)
+		}
+		if identity.ID != id {
+			t.Errorf("%s: identity %s, resource ID %s", result.DisplayName, identity.ID, id)
+		}
+		if result.DisplayName == "Production" {
+			// The SDK can't decode the boolean, the cluster is listed anyway.
+			var configurationJSON normalizedJSONValue
+			result.Diagnostics.Append(result.Resource.GetAttribute(ctx, path.Root("configuration_json"), &configurationJSON)...)
+			if equal, _ := configurationJSON.StringSemanticEquals(ctx, normalizedJSONStringValue(`{"debug":true,"gmtOffset":"-5"}`)); !equal {
+				t.Errorf("unexpected configuration_json %s", configurationJSON)
+			}
+		}
+		names = append(names, result.DisplayName)
+	}
+	if len(names) != 2 || names[0] != "Production" || names[1] != "Staging" {
+		t.Errorf("expected Production and Staging, got %v", names)
+	}
+}
+
+func TestBuildConfigurationPatchOpsMerge(t *testing.T) {
+	newConfig := map[string]attr.Value{"proxyHost": types.StringValue("proxy.example.com")}
+	oldConfig := map[string]attr.Value{"gmtOffset": types.StringValue("-5")}
+
+	for merge, expected := range map[bool][]string{
+		false: {"add /configuration/proxyHost", "remove /configuration/gmtOffset"},
+		true:  {"add /configuration/proxyHost"},
+	} {
+		ops, err := buildConfigurationPatchOps(context.Background(), newConfig, oldConfig, merge)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var got []string
+		for _, op := range ops {
+			got = append(got, op.Op+" "+op.Path)
+		}
+		if strings.Join(got, ", ") != strings.Join(expected, ", ") {
+			t.Errorf("merge %t: got %v, expected %v", merge, got, expected)
+		}
+	}
+}
@@ -3,13 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
-	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
 )
 
 var (
@@ -22,7 +20,7 @@ func NewManagedClustersDataSource() datasource.DataSource {
 }
 
 type managedClustersDataSource struct {
-	client *sailpoint.APIClient
+	data *providerData
 }
 
 func (d *managedClustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -31,13 +29,22 @@ func (d *managedClustersDataSource) Metadata(_ context.Context, req datasource.M
 
 func (d *managedClustersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Lists the managed clusters of the tenant, the groups of virtual appliances connecting sources to the tenant.",
 		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
 			"filters": schema.StringAttribute{
 				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
 				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters",
 			},
 			"managed_clusters": schema.ListNestedAttribute{
-				Computed: true,
+				Computed:    true,
+				Description: "Managed clusters of the tenant.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: managedClusterDataSourceSchemaAttributes,
 				},
@@ -55,53 +62,47 @@ func (d *managedClustersDataSource) Configure(ctx context.Context, req datasourc
 
 	tflog.Info(ctx, "Configuring SailPoint ManagedClusters data resource")
 
-	client, ok := req.ProviderData.(*sailpoint.APIClient)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *sailpoint.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
-}
-
-func extractNullableString(str *string, ok bool) *string {
-	if ok {
-		return str
-	}
-	return nil
+	d.data = data
 }
 
 func (d *managedClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Info(ctx, "Reading Managed Clusters")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
 	var state managedClustersDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	filters := state.Filters.ValueString()
 	tflog.Debug(ctx, "Reading Managed Clusters filters", map[string]any{"filters": filters})
 
-	results, res, err := sailpoint.PaginateWithDefaults[v2025.ManagedCluster](d.client.V2025.ManagedClustersAPI.GetManagedClusters(ctx).Filters(filters))
+	request := managedClustersRequest{request: client.V2025.ManagedClustersAPI.GetManagedClusters(ctx).Filters(filters)}
+	results, res, err := paginate[managedClusterRead](request, &state.paginationModel)
 
 	if err != nil {
-		defer res.Body.Close()
-		bodyBytes, _ := io.ReadAll(res.Body)
-		tflog.Error(ctx, "Error reading managed clusters", map[string]any{"error": err.Error(), "response_body": bodyBytes})
-		resp.Diagnostics.AddError(
-			"Unable to Read Managed Clusters",
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Managed Clusters", err, res)
 		return
 	}
 
 	state.ManagedClusters = make([]managedClusterSourceModel, 0)
-	for _, cluster := range results {
+	for _, read := range results {
 		tflog.Debug(ctx, "Iterating through the clusters")
 
-		clusterState, diags := serializeManagedClusterData(ctx, cluster)
+		clusterState, diags := serializeManagedClusterData(ctx, read.Cluster, read.Configuration)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
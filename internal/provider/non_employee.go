@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	nonEmployeeRecordDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the non-employee record.",
+		},
+		"account_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Account name of the non-employee.",
+		},
+		"first_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "First name of the non-employee.",
+		},
+		"last_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Last name of the non-employee.",
+		},
+		"email": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Email address of the non-employee.",
+		},
+		"phone": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Phone number of the non-employee.",
+		},
+		"manager": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Account name of the manager of the non-employee.",
+		},
+		"source_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the non-employee source of the record.",
+		},
+		"data": dataSchema.MapAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Additional attributes of the non-employee, as defined by the schema of its source.",
+		},
+		"start_date": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Date the non-employee starts.",
+		},
+		"end_date": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Date the non-employee leaves.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the record.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the record.",
+		},
+	}
+	nonEmployeeSourceDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the non-employee source.",
+		},
+		"source_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the source backing the non-employee source.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the non-employee source.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the non-employee source.",
+		},
+		"approvers": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: typedReferenceAttrTypes},
+			Description: "Identities approving the requests of the non-employee source.",
+		},
+		"account_managers": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: typedReferenceAttrTypes},
+			Description: "Identities managing the accounts of the non-employee source.",
+		},
+		"non_employee_count": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Number of non-employees of the source.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the non-employee source.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the non-employee source.",
+		},
+	}
+	nonEmployeeApprovalDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the approval item.",
+		},
+		"approver": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: typedReferenceAttrTypes,
+			Description:    "Identity approving the request.",
+		},
+		"account_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Account name of the non-employee requested.",
+		},
+		"approval_status": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the approval, such as APPROVED, PENDING or REJECTED.",
+		},
+		"approval_order": dataSchema.Float32Attribute{
+			Computed:    true,
+			Description: "Order of the approver in the approval chain.",
+		},
+		"comment": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Comment of the approver.",
+		},
+		"request_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the non-employee request.",
+		},
+		"requester": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: typedReferenceAttrTypes,
+			Description:    "Identity which requested the non-employee.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the approval item.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the approval item.",
+		},
+	}
+)
+
+type nonEmployeeRecordModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountName types.String `tfsdk:"account_name"`
+	FirstName   types.String `tfsdk:"first_name"`
+	LastName    types.String `tfsdk:"last_name"`
+	Email       types.String `tfsdk:"email"`
+	Phone       types.String `tfsdk:"phone"`
+	Manager     types.String `tfsdk:"manager"`
+	SourceID    types.String `tfsdk:"source_id"`
+	Data        types.Map    `tfsdk:"data"`
+	StartDate   rfc3339Value `tfsdk:"start_date"`
+	EndDate     rfc3339Value `tfsdk:"end_date"`
+	Created     rfc3339Value `tfsdk:"created"`
+	Modified    rfc3339Value `tfsdk:"modified"`
+}
+
+type nonEmployeeRecordsDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters            types.String             `tfsdk:"sorters"`
+	SourceID           types.String             `tfsdk:"source_id"`
+	Filters            types.String             `tfsdk:"filters"`
+	NonEmployeeRecords []nonEmployeeRecordModel `tfsdk:"non_employee_records"`
+}
+
+type nonEmployeeSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	SourceID         types.String `tfsdk:"source_id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Approvers        types.List   `tfsdk:"approvers"`
+	AccountManagers  types.List   `tfsdk:"account_managers"`
+	NonEmployeeCount types.Int32  `tfsdk:"non_employee_count"`
+	Created          rfc3339Value `tfsdk:"created"`
+	Modified         rfc3339Value `tfsdk:"modified"`
+}
+
+type nonEmployeeSourcesDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters            types.String             `tfsdk:"sorters"`
+	RequestedFor       types.String             `tfsdk:"requested_for"`
+	NonEmployeeCount   types.Bool               `tfsdk:"non_employee_count"`
+	NonEmployeeSources []nonEmployeeSourceModel `tfsdk:"non_employee_sources"`
+}
+
+type nonEmployeeApprovalModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Approver       types.Object  `tfsdk:"approver"`
+	AccountName    types.String  `tfsdk:"account_name"`
+	ApprovalStatus types.String  `tfsdk:"approval_status"`
+	ApprovalOrder  types.Float32 `tfsdk:"approval_order"`
+	Comment        types.String  `tfsdk:"comment"`
+	RequestID      types.String  `tfsdk:"request_id"`
+	Requester      types.Object  `tfsdk:"requester"`
+	Created        rfc3339Value  `tfsdk:"created"`
+	Modified       rfc3339Value  `tfsdk:"modified"`
+}
+
+type nonEmployeeApprovalSummaryModel struct {
+	Approved types.Int32 `tfsdk:"approved"`
+	Pending  types.Int32 `tfsdk:"pending"`
+	Rejected types.Int32 `tfsdk:"rejected"`
+}
+
+type nonEmployeeApprovalsDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters      types.String                    `tfsdk:"sorters"`
+	RequestedFor types.String                    `tfsdk:"requested_for"`
+	Filters      types.String                    `tfsdk:"filters"`
+	Summary      nonEmployeeApprovalSummaryModel `tfsdk:"summary"`
+	Approvals    []nonEmployeeApprovalModel      `tfsdk:"approvals"`
+}
+
+func serializeNonEmployeeIdentityReference(ctx context.Context, ref *api_v2025.NonEmployeeIdentityReferenceWithId) (types.Object, diag.Diagnostics) {
+	if ref == nil {
+		return types.ObjectNull(typedReferenceAttrTypes), nil
+	}
+
+	var refType *string
+	if ref.Type != nil {
+		t := string(*ref.Type)
+		refType = &t
+	}
+
+	return types.ObjectValueFrom(ctx, typedReferenceAttrTypes, typedReferenceModel{
+		Type: types.StringPointerValue(refType),
+		ID:   types.StringPointerValue(ref.Id),
+	})
+}
+
+func serializeNonEmployeeIdentityReferences(ctx context.Context, refs []api_v2025.NonEmployeeIdentityReferenceWithId) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: typedReferenceAttrTypes}
+
+	values := make([]attr.Value, 0, len(refs))
+	for i := range refs {
+		value, diags := serializeNonEmployeeIdentityReference(ctx, &refs[i])
+		if diags.HasError() {
+			return types.ListNull(elemType), diags
+		}
+		values = append(values, value)
+	}
+
+	return types.ListValue(elemType, values)
+}
+
+func serializeNonEmployeeRecordData(ctx context.Context, record api_v2025.NonEmployeeRecord) (nonEmployeeRecordModel, diag.Diagnostics) {
+	data, diags := types.MapValueFrom(ctx, types.StringType, record.Data)
+	if diags.HasError() {
+		return nonEmployeeRecordModel{}, diags
+	}
+
+	return nonEmployeeRecordModel{
+		ID:          types.StringPointerValue(record.Id),
+		AccountName: types.StringPointerValue(record.AccountName),
+		FirstName:   types.StringPointerValue(record.FirstName),
+		LastName:    types.StringPointerValue(record.LastName),
+		Email:       types.StringPointerValue(record.Email),
+		Phone:       types.StringPointerValue(record.Phone),
+		Manager:     types.StringPointerValue(record.Manager),
+		SourceID:    types.StringPointerValue(record.SourceId),
+		Data:        data,
+		StartDate:   sailPointTimeValue(record.StartDate),
+		EndDate:     sailPointTimeValue(record.EndDate),
+		Created:     sailPointTimeValue(record.Created),
+		Modified:    sailPointTimeValue(record.Modified),
+	}, nil
+}
+
+func serializeNonEmployeeSourceData(ctx context.Context, source api_v2025.NonEmployeeSourceWithNECount) (nonEmployeeSourceModel, diag.Diagnostics) {
+	approvers, diags := serializeNonEmployeeIdentityReferences(ctx, source.Approvers)
+	if diags.HasError() {
+		return nonEmployeeSourceModel{}, diags
+	}
+
+	accountManagers, diags := serializeNonEmployeeIdentityReferences(ctx, source.AccountManagers)
+	if diags.HasError() {
+		return nonEmployeeSourceModel{}, diags
+	}
+
+	return nonEmployeeSourceModel{
+		ID:               types.StringPointerValue(source.Id),
+		SourceID:         types.StringPointerValue(source.SourceId),
+		Name:             types.StringPointerValue(source.Name),
+		Description:      types.StringPointerValue(source.Description),
+		Approvers:        approvers,
+		AccountManagers:  accountManagers,
+		NonEmployeeCount: types.Int32PointerValue(source.NonEmployeeCount.Get()),
+		Created:          sailPointTimeValue(source.Created),
+		Modified:         sailPointTimeValue(source.Modified),
+	}, nil
+}
+
+func serializeNonEmployeeApprovalData(ctx context.Context, approval api_v2025.NonEmployeeApprovalItem) (nonEmployeeApprovalModel, diag.Diagnostics) {
+	approver, diags := serializeNonEmployeeIdentityReference(ctx, approval.Approver)
+	if diags.HasError() {
+		return nonEmployeeApprovalModel{}, diags
+	}
+
+	var requestID *string
+	var requesterRef *api_v2025.NonEmployeeIdentityReferenceWithId
+	if approval.NonEmployeeRequest != nil {
+		requestID = approval.NonEmployeeRequest.Id
+		requesterRef = approval.NonEmployeeRequest.Requester
+	}
+
+	requester, diags := serializeNonEmployeeIdentityReference(ctx, requesterRef)
+	if diags.HasError() {
+		return nonEmployeeApprovalModel{}, diags
+	}
+
+	var approvalStatus *string
+	if approval.ApprovalStatus != nil {
+		s := string(*approval.ApprovalStatus)
+		approvalStatus = &s
+	}
+
+	return nonEmployeeApprovalModel{
+		ID:             types.StringPointerValue(approval.Id),
+		Approver:       approver,
+		AccountName:    types.StringPointerValue(approval.AccountName),
+		ApprovalStatus: types.StringPointerValue(approvalStatus),
+		ApprovalOrder:  types.Float32PointerValue(approval.ApprovalOrder),
+		Comment:        types.StringPointerValue(approval.Comment),
+		RequestID:      types.StringPointerValue(requestID),
+		Requester:      requester,
+		Created:        sailPointTimeValue(approval.Created),
+		Modified:       sailPointTimeValue(approval.Modified),
+	}, nil
+}
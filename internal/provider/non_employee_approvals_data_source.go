@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &nonEmployeeApprovalsDataSource{}
+	_ datasource.DataSourceWithConfigure = &nonEmployeeApprovalsDataSource{}
+)
+
+func NewNonEmployeeApprovalsDataSource() datasource.DataSource {
+	return &nonEmployeeApprovalsDataSource{}
+}
+
+type nonEmployeeApprovalsDataSource struct {
+	data *providerData
+}
+
+func (d *nonEmployeeApprovalsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_non_employee_approvals"
+}
+
+func (d *nonEmployeeApprovalsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the approval items of non-employee requests.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"requested_for": schema.StringAttribute{
+				Optional:    true,
+				Description: "The approver identity ID the approvals and the summary are retrieved for, defaults to \"me\" (the current user)",
+			},
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters (ex. approvalStatus eq \"PENDING\")",
+			},
+			"summary": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Number of approval items per status.",
+				Attributes: map[string]schema.Attribute{
+					"approved": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of approved items.",
+					},
+					"pending": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of pending items.",
+					},
+					"rejected": schema.Int32Attribute{
+						Computed:    true,
+						Description: "Number of rejected items.",
+					},
+				},
+			},
+			"approvals": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Approval items.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nonEmployeeApprovalDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *nonEmployeeApprovalsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint NonEmployeeApprovals data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *nonEmployeeApprovalsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Non-Employee Approvals")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state nonEmployeeApprovalsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestedFor := state.RequestedFor.ValueString()
+	if requestedFor == "" {
+		requestedFor = "me"
+	}
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Non-Employee Approvals filters", map[string]any{"requested_for": requestedFor, "filters": filters})
+
+	summary, res, err := client.V2025.NonEmployeeLifecycleManagementAPI.GetNonEmployeeApprovalSummary(ctx, requestedFor).Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Non-Employee Approval Summary", err, res)
+		return
+	}
+
+	state.Summary = nonEmployeeApprovalSummaryModel{
+		Approved: types.Int32PointerValue(summary.Approved),
+		Pending:  types.Int32PointerValue(summary.Pending),
+		Rejected: types.Int32PointerValue(summary.Rejected),
+	}
+
+	request := client.V2025.NonEmployeeLifecycleManagementAPI.ListNonEmployeeApprovals(ctx).RequestedFor(requestedFor)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+	if !state.Sorters.IsNull() {
+		request = request.Sorters(state.Sorters.ValueString())
+	}
+
+	results, res, err := paginate[v2025.NonEmployeeApprovalItem](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Non-Employee Approvals", err, res)
+		return
+	}
+
+	state.Approvals = make([]nonEmployeeApprovalModel, 0, len(results))
+	for _, approval := range results {
+		approvalState, diags := serializeNonEmployeeApprovalData(ctx, approval)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Approvals = append(state.Approvals, approvalState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
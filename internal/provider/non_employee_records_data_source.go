@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &nonEmployeeRecordsDataSource{}
+	_ datasource.DataSourceWithConfigure = &nonEmployeeRecordsDataSource{}
+)
+
+func NewNonEmployeeRecordsDataSource() datasource.DataSource {
+	return &nonEmployeeRecordsDataSource{}
+}
+
+type nonEmployeeRecordsDataSource struct {
+	data *providerData
+}
+
+func (d *nonEmployeeRecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_non_employee_records"
+}
+
+func (d *nonEmployeeRecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the non-employee records of the tenant, such as contractors.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"source_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "Only return the records belonging to this non-employee source",
+			},
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters",
+			},
+			"non_employee_records": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Non-employee records of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nonEmployeeRecordDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *nonEmployeeRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint NonEmployeeRecords data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *nonEmployeeRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Non-Employee Records")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state nonEmployeeRecordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	if sourceID := state.SourceID.ValueString(); sourceID != "" {
+		sourceFilter := "sourceId eq " + quoteFilterString(sourceID)
+		if filters != "" {
+			filters = fmt.Sprintf("%s and (%s)", sourceFilter, filters)
+		} else {
+			filters = sourceFilter
+		}
+	}
+	tflog.Debug(ctx, "Reading Non-Employee Records filters", map[string]any{"filters": filters})
+
+	request := client.V2025.NonEmployeeLifecycleManagementAPI.ListNonEmployeeRecords(ctx)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+	if !state.Sorters.IsNull() {
+		request = request.Sorters(state.Sorters.ValueString())
+	}
+
+	results, res, err := paginate[v2025.NonEmployeeRecord](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Non-Employee Records", err, res)
+		return
+	}
+
+	state.NonEmployeeRecords = make([]nonEmployeeRecordModel, 0, len(results))
+	for _, record := range results {
+		recordState, diags := serializeNonEmployeeRecordData(ctx, record)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.NonEmployeeRecords = append(state.NonEmployeeRecords, recordState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNonEmployeeRecordsDataSourceReadSourceFilter(t *testing.T) {
+	fake := newFakeSailPoint(t)
+	fake.handle(http.MethodGet, "/v2025/non-employee-records", func(w http.ResponseWriter, r *http.Request) {
+		// The filters of the configuration are grouped so an or doesn't
+		// escape the source.
+		if filters := r.URL.Query().Get("filters"); filters != `sourceId eq "2c9180857182305e0171993735622948" and (lastName eq "Smith" or lastName eq "Jones")` {
+			t.Errorf("unexpected filters %q", filters)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	_, diags := readTestDataSource(t, NewNonEmployeeRecordsDataSource(), fake.providerData(), map[string]tftypes.Value{
+		"source_id": tftypes.NewValue(tftypes.String, "2c9180857182305e0171993735622948"),
+		"filters":   tftypes.NewValue(tftypes.String, `lastName eq "Smith" or lastName eq "Jones"`),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &nonEmployeeSourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &nonEmployeeSourcesDataSource{}
+)
+
+func NewNonEmployeeSourcesDataSource() datasource.DataSource {
+	return &nonEmployeeSourcesDataSource{}
+}
+
+type nonEmployeeSourcesDataSource struct {
+	data *providerData
+}
+
+func (d *nonEmployeeSourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_non_employee_sources"
+}
+
+func (d *nonEmployeeSourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the non-employee sources of the tenant, which hold the non-employee records.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"requested_for": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the sources where this identity is an account manager, \"me\" can be used for the current user",
+			},
+			"non_employee_count": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to populate the number of non-employee records of each source",
+			},
+			"non_employee_sources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Non-employee sources of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nonEmployeeSourceDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *nonEmployeeSourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint NonEmployeeSources data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *nonEmployeeSourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Non-Employee Sources")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state nonEmployeeSourcesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := client.V2025.NonEmployeeLifecycleManagementAPI.ListNonEmployeeSources(ctx)
+	if requestedFor := state.RequestedFor.ValueString(); requestedFor != "" {
+		request = request.RequestedFor(requestedFor)
+	}
+	if state.NonEmployeeCount.ValueBool() {
+		request = request.NonEmployeeCount(true)
+	}
+	if !state.Sorters.IsNull() {
+		request = request.Sorters(state.Sorters.ValueString())
+	}
+
+	results, res, err := paginate[v2025.NonEmployeeSourceWithNECount](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Non-Employee Sources", err, res)
+		return
+	}
+
+	state.NonEmployeeSources = make([]nonEmployeeSourceModel, 0, len(results))
+	for _, source := range results {
+		sourceState, diags := serializeNonEmployeeSourceData(ctx, source)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.NonEmployeeSources = append(state.NonEmployeeSources, sourceState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
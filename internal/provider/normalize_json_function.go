@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = normalizeJSONFunction{}
+)
+
+// serverManagedJSONFields are set by the API on the objects it returns and
+// are meaningless in a definition written by users.
+var serverManagedJSONFields = []string{"id", "created", "modified"}
+
+func NewNormalizeJSONFunction() function.Function {
+	return normalizeJSONFunction{}
+}
+
+// normalizeJSONFunction canonicalizes SailPoint JSON documents so that
+// definitions read back from the API compare equal to the ones written in
+// the configuration.
+type normalizeJSONFunction struct{}
+
+func (f normalizeJSONFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_sailpoint_json"
+}
+
+func (f normalizeJSONFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Canonicalize a SailPoint JSON document",
+		MarkdownDescription: fmt.Sprintf("Returns the JSON document with its keys sorted, without insignificant whitespace and without the fields the API manages (%s) "+
+			"of the top level object, or of every object of a top level list. Use it on both sides of a comparison to avoid spurious differences in plans.", strings.Join(serverManagedJSONFields, ", ")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				MarkdownDescription: "JSON document to normalize.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "remove",
+			MarkdownDescription: "Additional top level fields to remove (ex. `owner`).",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f normalizeJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+	var remove []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document, &remove))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeSailPointJSON(document, append(remove, serverManagedJSONFields...))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid JSON document: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
+
+// normalizeSailPointJSON re-encodes document with sorted keys, dropping the
+// given fields from the top level object or from the objects of a top level
+// list. Numbers are kept as written so large IDs don't lose precision.
+func normalizeSailPointJSON(document string, remove []string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+	if decoder.More() {
+		return "", fmt.Errorf("unexpected data after the JSON document")
+	}
+
+	dropFields := func(v any) {
+		if object, ok := v.(map[string]any); ok {
+			for _, field := range remove {
+				delete(object, field)
+			}
+		}
+	}
+	if list, ok := value.([]any); ok {
+		for _, element := range list {
+			dropFields(element)
+		}
+	} else {
+		dropFields(value)
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeSailPointJSON(t *testing.T) {
+	tests := map[string]struct {
+		document string
+		remove   []string
+		want     string
+		wantErr  bool
+	}{
+		"object": {
+			document: `{ "name": "a <b>", "id": "1", "created": "2024-01-01", "attributes": {"z": 1, "a": 12345678901234567890, "id": "kept"} }`,
+			remove:   serverManagedJSONFields,
+			want:     `{"attributes":{"a":12345678901234567890,"id":"kept","z":1},"name":"a <b>"}`,
+		},
+		"list": {
+			document: `[{"id":"1","name":"a","owner":{"id":"2"}},"b"]`,
+			remove:   append([]string{"owner"}, serverManagedJSONFields...),
+			want:     `[{"name":"a"},"b"]`,
+		},
+		"invalid": {
+			document: `{"name":`,
+			wantErr:  true,
+		},
+		"trailing data": {
+			document: `{} {}`,
+			wantErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := normalizeSailPointJSON(test.document, test.remove)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
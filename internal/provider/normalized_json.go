@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = normalizedJSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = normalizedJSONValue{}
+)
+
+// normalizedJSONType is the custom type of the attributes holding JSON
+// encoded documents. Two documents differing only in whitespace or in the
+// order of their object keys are semantically equal, so reformatting by the
+// API or by jsonencode doesn't show as a diff.
+type normalizedJSONType struct {
+	basetypes.StringType
+}
+
+func (t normalizedJSONType) String() string {
+	return "normalizedJSONType"
+}
+
+func (t normalizedJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(normalizedJSONType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t normalizedJSONType) ValueType(_ context.Context) attr.Value {
+	return normalizedJSONValue{}
+}
+
+func (t normalizedJSONType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return normalizedJSONValue{StringValue: in}, nil
+}
+
+func (t normalizedJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := value.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+
+	return normalizedJSONValue{StringValue: stringValue}, nil
+}
+
+// normalizedJSONValue is a JSON document attribute value, see
+// normalizedJSONType.
+type normalizedJSONValue struct {
+	basetypes.StringValue
+}
+
+func (v normalizedJSONValue) Type(_ context.Context) attr.Type {
+	return normalizedJSONType{}
+}
+
+func (v normalizedJSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(normalizedJSONValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v normalizedJSONValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(normalizedJSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldDocument, err := normalizeSailPointJSON(v.ValueString(), nil)
+	if err != nil {
+		return false, diags
+	}
+	newDocument, err := normalizeSailPointJSON(newValue.ValueString(), nil)
+	if err != nil {
+		return false, diags
+	}
+
+	return oldDocument == newDocument, diags
+}
+
+// normalizedJSONNull returns a null JSON document value.
+func normalizedJSONNull() normalizedJSONValue {
+	return normalizedJSONValue{StringValue: basetypes.NewStringNull()}
+}
+
+// normalizedJSONStringValue returns the JSON document value of document.
+func normalizedJSONStringValue(document string) normalizedJSONValue {
+	return normalizedJSONValue{StringValue: basetypes.NewStringValue(document)}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNormalizedJSONValueSemanticEquals(t *testing.T) {
+	tests := []struct {
+		old, new string
+		equal    bool
+	}{
+		{`{"a":1,"b":[true,null]}`, `{ "b": [true, null], "a": 1 }`, true},
+		{`{"id":12345678901234567890}`, `{"id": 12345678901234567890}`, true},
+		{`{"a":1}`, `{"a":2}`, false},
+		{`[1,2]`, `[2,1]`, false},
+		{`{"a":1}`, `not json`, false},
+	}
+
+	for _, test := range tests {
+		equal, diags := normalizedJSONStringValue(test.old).StringSemanticEquals(context.Background(), normalizedJSONStringValue(test.new))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if equal != test.equal {
+			t.Errorf("%s and %s: got equal %t", test.old, test.new, equal)
+		}
+	}
+}
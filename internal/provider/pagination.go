@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+)
+
+const (
+	// maxPageSize is the largest page the collection endpoints return.
+	maxPageSize = 250
+	// defaultMaxResults matches the cap of the SDK paginator the list data
+	// sources used before pagination was configurable.
+	defaultMaxResults = 10000
+)
+
+var (
+	limitDataSourceSchemaAttribute = dataSchema.Int64Attribute{
+		Optional:    true,
+		Validators:  []validator.Int64{int64RangeValidator{min: 1, max: maxPageSize}},
+		Description: fmt.Sprintf("Number of results requested per page, between 1 and %d. Defaults to %d.", maxPageSize, maxPageSize),
+	}
+	offsetDataSourceSchemaAttribute = dataSchema.Int64Attribute{
+		Optional:    true,
+		Validators:  []validator.Int64{int64RangeValidator{min: 0}},
+		Description: "Number of results skipped before the first returned one. Defaults to 0.",
+	}
+	maxResultsDataSourceSchemaAttribute = dataSchema.Int64Attribute{
+		Optional:    true,
+		Validators:  []validator.Int64{int64RangeValidator{min: 0}},
+		Description: fmt.Sprintf("Maximum number of results returned, 0 for no limit. Defaults to %d.", defaultMaxResults),
+	}
+	totalDataSourceSchemaAttribute = dataSchema.Int64Attribute{
+		Computed:    true,
+		Description: "Total number of objects matching the filters, as reported by the API regardless of the pagination. Null when the endpoint doesn't report it.",
+	}
+	countOnlyDataSourceSchemaAttribute = dataSchema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to only read total, without fetching the objects themselves. Defaults to false.",
+	}
+	sortersDataSourceSchemaAttribute = dataSchema.StringAttribute{
+		Optional:    true,
+		Validators:  []validator.String{stringPatternValidator{pattern: sortersPattern, message: "must be a comma separated list of properties, prefixed with - for descending order (ex. name,-created)"}},
+		Description: "Comma separated properties the results are sorted by, prefixed with - for descending order (ex. name,-created), using the standard syntax described in V3 API Standard Collection Parameters. The supported properties depend on the endpoint.",
+	}
+
+	sortersPattern = regexp.MustCompile(`^-?[A-Za-z_][A-Za-z0-9_.]*(\s*,\s*-?[A-Za-z_][A-Za-z0-9_.]*)*$`)
+)
+
+// totalCountHeader is the header in which the collection endpoints report
+// the total number of objects when count=true is requested.
+const totalCountHeader = "X-Total-Count"
+
+// paginationModel maps the pagination attributes of the list data sources,
+// it is embedded in their models.
+type paginationModel struct {
+	Limit      types.Int64 `tfsdk:"limit"`
+	Offset     types.Int64 `tfsdk:"offset"`
+	MaxResults types.Int64 `tfsdk:"max_results"`
+	Total      types.Int64 `tfsdk:"total"`
+	CountOnly  types.Bool  `tfsdk:"count_only"`
+}
+
+// paginate fetches the pages of a collection request of the SDK, which must
+// have Limit, Offset, Count and Execute methods, as configured by pagination.
+// Unlike the SDK paginator, it never returns more than max_results objects.
+// The total reported by the first page is stored in pagination.Total, and
+// only that page is requested, with a single object, in count_only mode.
+func paginate[T any](request any, pagination *paginationModel) ([]T, *http.Response, error) {
+	offset := int(pagination.Offset.ValueInt64())
+	pageSize := maxPageSize
+	if !pagination.Limit.IsNull() {
+		pageSize = int(pagination.Limit.ValueInt64())
+	}
+	maxResults := defaultMaxResults
+	if !pagination.MaxResults.IsNull() {
+		maxResults = int(pagination.MaxResults.ValueInt64())
+	}
+	countOnly := pagination.CountOnly.ValueBool()
+	if countOnly {
+		pageSize, maxResults = 1, 1
+	}
+
+	pagination.Total = types.Int64Null()
+	request = sailpoint.Invoke(request, "Count", true)[0].Interface()
+
+	results := make([]T, 0)
+	var res *http.Response
+	for maxResults == 0 || len(results) < maxResults {
+		size := pageSize
+		if maxResults > 0 {
+			size = min(size, maxResults-len(results))
+		}
+
+		paged := sailpoint.Invoke(request, "Limit", int32(size))[0].Interface()
+		paged = sailpoint.Invoke(paged, "Offset", int32(offset))[0].Interface()
+		out := sailpoint.Invoke(paged, "Execute")
+
+		page, _ := out[0].Interface().([]T)
+		res, _ = out[1].Interface().(*http.Response)
+		if err, ok := out[2].Interface().(error); ok && err != nil {
+			return results, res, err
+		}
+
+		if pagination.Total.IsNull() && res != nil {
+			if total, err := strconv.ParseInt(res.Header.Get(totalCountHeader), 10, 64); err == nil {
+				pagination.Total = types.Int64Value(total)
+			}
+		}
+		if countOnly {
+			return make([]T, 0), res, nil
+		}
+
+		results = append(results, page...)
+		if len(page) < size {
+			break
+		}
+		offset += size
+	}
+
+	return results, res, nil
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testPagedRequest mimics the collection requests of the SDK over a
+// collection of n integers.
+type testPagedRequest struct {
+	n      int
+	limit  int32
+	offset int32
+	count  bool
+	calls  *int
+}
+
+func (r testPagedRequest) Limit(limit int32) testPagedRequest {
+	r.limit = limit
+	return r
+}
+
+func (r testPagedRequest) Offset(offset int32) testPagedRequest {
+	r.offset = offset
+	return r
+}
+
+func (r testPagedRequest) Count(count bool) testPagedRequest {
+	r.count = count
+	return r
+}
+
+func (r testPagedRequest) Execute() ([]int, *http.Response, error) {
+	*r.calls++
+	page := make([]int, 0, r.limit)
+	for i := int(r.offset); i < r.n && i < int(r.offset+r.limit); i++ {
+		page = append(page, i)
+	}
+	res := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	if r.count {
+		res.Header.Set(totalCountHeader, strconv.Itoa(r.n))
+	}
+	return page, res, nil
+}
+
+func TestPaginate(t *testing.T) {
+	tests := map[string]struct {
+		n          int
+		pagination paginationModel
+		wantFirst  int
+		wantLen    int
+		wantCalls  int
+	}{
+		"defaults": {
+			n:          600,
+			pagination: paginationModel{Limit: types.Int64Null(), Offset: types.Int64Null(), MaxResults: types.Int64Null()},
+			wantFirst:  0, wantLen: 600, wantCalls: 3,
+		},
+		"max results": {
+			n:          600,
+			pagination: paginationModel{Limit: types.Int64Value(100), Offset: types.Int64Value(50), MaxResults: types.Int64Value(120)},
+			wantFirst:  50, wantLen: 120, wantCalls: 2,
+		},
+		"unlimited": {
+			n:          30,
+			pagination: paginationModel{Limit: types.Int64Value(10), Offset: types.Int64Null(), MaxResults: types.Int64Value(0)},
+			wantFirst:  0, wantLen: 30, wantCalls: 4,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			results, _, err := paginate[int](testPagedRequest{n: test.n, calls: &calls}, &test.pagination)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(results) != test.wantLen || results[0] != test.wantFirst || !slices.IsSorted(results) {
+				t.Errorf("unexpected results: %d results starting at %d", len(results), results[0])
+			}
+			if calls != test.wantCalls {
+				t.Errorf("got %d calls, want %d", calls, test.wantCalls)
+			}
+			if test.pagination.Total.ValueInt64() != int64(test.n) {
+				t.Errorf("got total %s, want %d", test.pagination.Total, test.n)
+			}
+		})
+	}
+}
+
+func TestPaginateCountOnly(t *testing.T) {
+	calls := 0
+	pagination := paginationModel{CountOnly: types.BoolValue(true)}
+
+	results, _, err := paginate[int](testPagedRequest{n: 600, calls: &calls}, &pagination)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != 0 || calls != 1 {
+		t.Errorf("expected a single request and no results, got %d calls and %d results", calls, len(results))
+	}
+	if pagination.Total.ValueInt64() != 600 {
+		t.Errorf("got total %s, want 600", pagination.Total)
+	}
+}
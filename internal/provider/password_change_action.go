@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const passwordChangeDefaultTimeout = 10 * time.Minute
+
+// States of the password change requests.
+const (
+	passwordChangeFinished = "FINISHED"
+	passwordChangeFailed   = "FAILED"
+)
+
+var (
+	_ action.Action              = &passwordChangeAction{}
+	_ action.ActionWithConfigure = &passwordChangeAction{}
+)
+
+func NewPasswordChangeAction() action.Action {
+	return &passwordChangeAction{}
+}
+
+type passwordChangeAction struct {
+	data *providerData
+}
+
+type passwordChangeActionModel struct {
+	UserName   types.String `tfsdk:"user_name"`
+	SourceName types.String `tfsdk:"source_name"`
+	AccountID  types.String `tfsdk:"account_id"`
+	Password   types.String `tfsdk:"password"`
+	Wait       types.Bool   `tfsdk:"wait"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+func (a *passwordChangeAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_change"
+}
+
+func (a *passwordChangeAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets the password of an account, as when resetting service account passwords, and reports the state of the password change request. The password is encrypted with the public key of the source before being sent, and is write-only so it can be an ephemeral value.",
+		Attributes: map[string]schema.Attribute{
+			"user_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Login name of the identity owning the account.",
+			},
+			"source_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Display name of the source of the account.",
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Native ID of the account on the source, as designated by the account schema. Required when the identity has more than one account on the source.",
+			},
+			"password": schema.StringAttribute{
+				Required:    true,
+				WriteOnly:   true,
+				Description: "New password of the account.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the password change to finish on the source. Defaults to false.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{durationValidator{}},
+				Description: "How long to wait for the password change to finish, as a Go duration string (defaults to 10m).",
+			},
+		},
+	}
+}
+
+func (a *passwordChangeAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Password Change action")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.data = data
+}
+
+func (a *passwordChangeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Info(ctx, "Invoking Password Change")
+	defer a.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var config passwordChangeActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := a.data.client()
+	query := api_v2025.PasswordInfoQueryDTO{
+		UserName:   config.UserName.ValueStringPointer(),
+		SourceName: config.SourceName.ValueStringPointer(),
+	}
+	info, res, err := client.V2025.PasswordManagementAPI.QueryPasswordInfo(ctx).PasswordInfoQueryDTO(query).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Query Password Info", err, res)
+		return
+	}
+
+	accountID, err := passwordChangeAccountID(info, config.AccountID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("account_id"), "Invalid Password Change Account", err.Error())
+		return
+	}
+	encrypted, err := encryptPassword(info.GetPublicKey(), config.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Encrypt Password",
+			fmt.Sprintf("The password could not be encrypted with the public key of source %q: %s", config.SourceName.ValueString(), err),
+		)
+		return
+	}
+
+	request := api_v2025.PasswordChangeRequest{
+		IdentityId:        info.IdentityId,
+		EncryptedPassword: &encrypted,
+		PublicKeyId:       info.PublicKeyId,
+		AccountId:         &accountID,
+		SourceId:          info.SourceId,
+	}
+	change, res, err := client.V2025.PasswordManagementAPI.SetPassword(ctx).PasswordChangeRequest(request).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Change Password", err, res)
+		return
+	}
+
+	requestID := change.GetRequestId()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Password change request %s of account %s is %s", requestID, accountID, change.GetState())})
+	if !config.Wait.ValueBool() {
+		return
+	}
+
+	timeout := timeoutValue(config.Timeout, passwordChangeDefaultTimeout)
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	task := "Password change request " + requestID
+	state, res, err := waitPasswordChange(pollCtx, client, task, requestID)
+	if err != nil {
+		addTaskPollError(ctx, &resp.Diagnostics, "Password Change", task, timeout, state, err, res)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Password change request %s is %s", requestID, state)})
+}
+
+// passwordChangeAccountID returns the account whose password is changed:
+// accountID, which must be an account of the identity on the source, or its
+// only account there.
+func passwordChangeAccountID(info *api_v2025.PasswordInfo, accountID string) (string, error) {
+	accounts := make([]string, 0, len(info.Accounts))
+	for _, account := range info.Accounts {
+		if accountID != "" && account.GetAccountId() == accountID {
+			return accountID, nil
+		}
+		accounts = append(accounts, account.GetAccountId())
+	}
+
+	switch {
+	case accountID != "":
+		return "", fmt.Errorf("the identity has no account %q on the source, its accounts are: %s", accountID, strings.Join(accounts, ", "))
+	case len(accounts) == 0:
+		return "", errors.New("the identity has no account on the source")
+	case len(accounts) > 1:
+		return "", fmt.Errorf("the identity has %d accounts on the source, account_id must be one of: %s", len(accounts), strings.Join(accounts, ", "))
+	}
+	return accounts[0], nil
+}
+
+// encryptPassword encrypts password with the base64 encoded RSA public key
+// of a source, as the password change API expects it.
+func encryptPassword(publicKey string, password string) (string, error) {
+	der, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("decoding the public key: %w", err)
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return "", fmt.Errorf("parsing the public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("the public key is a %T, not an RSA key", key)
+	}
+
+	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, rsaKey, []byte(password))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// waitPasswordChange polls a password change request until it finishes. task
+// describes the request as in pollTask.
+func waitPasswordChange(ctx context.Context, client *sailpoint.APIClient, task string, requestID string) (string, *http.Response, error) {
+	return pollTask(ctx, task, func(ctx context.Context) (taskPoll, *http.Response, error) {
+		status, res, err := client.V2025.PasswordManagementAPI.GetPasswordChangeStatus(ctx, requestID).Execute()
+		if err != nil {
+			return taskPoll{}, res, err
+		}
+
+		state := status.GetState()
+		switch state {
+		case passwordChangeFinished:
+			return taskPoll{Status: state, Done: true}, res, nil
+		case passwordChangeFailed:
+			if len(status.Errors) > 0 {
+				state += ": " + strings.Join(status.Errors, "; ")
+			}
+			return taskPoll{}, res, &taskFailedError{Task: task, Status: state}
+		}
+		return taskPoll{Status: state}, res, nil
+	})
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestEncryptPassword(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted, err := encryptPassword(base64.StdEncoding.EncodeToString(der), "s3cret!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := rsa.DecryptPKCS1v15(nil, key, ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(decrypted) != "s3cret!" {
+		t.Errorf("expected s3cret!, got %q", decrypted)
+	}
+
+	if _, err := encryptPassword("not a key", "s3cret!"); err == nil {
+		t.Error("expected an invalid key to fail")
+	}
+}
+
+func TestPasswordChangeAccountID(t *testing.T) {
+	one := &api_v2025.PasswordInfo{Accounts: []api_v2025.PasswordInfoAccount{{AccountId: api_v2025.PtrString("jdoe")}}}
+	two := &api_v2025.PasswordInfo{Accounts: []api_v2025.PasswordInfoAccount{{AccountId: api_v2025.PtrString("jdoe")}, {AccountId: api_v2025.PtrString("jdoe-admin")}}}
+
+	for name, test := range map[string]struct {
+		info      *api_v2025.PasswordInfo
+		accountID string
+		expected  string
+	}{
+		"only account":     {one, "", "jdoe"},
+		"selected account": {two, "jdoe-admin", "jdoe-admin"},
+		"ambiguous":        {two, "", ""},
+		"unknown account":  {one, "jsmith", ""},
+		"no account":       {&api_v2025.PasswordInfo{}, "", ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			accountID, err := passwordChangeAccountID(test.info, test.accountID)
+			if accountID != test.expected || (err == nil) != (test.expected != "") {
+				t.Errorf("expected %q, got %q, %v", test.expected, accountID, err)
+			}
+		})
+	}
+}
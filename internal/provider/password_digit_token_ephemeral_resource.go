@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &passwordDigitTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &passwordDigitTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &passwordDigitTokenEphemeralResource{}
+)
+
+func NewPasswordDigitTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &passwordDigitTokenEphemeralResource{}
+}
+
+type passwordDigitTokenEphemeralResource struct {
+	data *providerData
+}
+
+type passwordDigitTokenEphemeralResourceModel struct {
+	UserID          types.String `tfsdk:"user_id"`
+	Length          types.Int32  `tfsdk:"length"`
+	DurationMinutes types.Int32  `tfsdk:"duration_minutes"`
+	DigitToken      types.String `tfsdk:"digit_token"`
+	RequestID       types.String `tfsdk:"request_id"`
+}
+
+func (r *passwordDigitTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_digit_token"
+}
+
+func (r *passwordDigitTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Digit token a user resets their password with, as generated by the help desk. A new token is generated every time Terraform opens the ephemeral resource, during plan and apply, and it is never stored in the plan or the state. Digit tokens must be enabled in the password org settings, and the API is experimental.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "UID of the user the token is generated for.",
+			},
+			"length": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Number of digits of the token. Defaults to the digit token length of the password org settings.",
+			},
+			"duration_minutes": schema.Int32Attribute{
+				Optional:    true,
+				Description: "How long the token is valid, in minutes. Defaults to the digit token duration of the password org settings.",
+			},
+			"digit_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The digit token.",
+			},
+			"request_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the token generation request.",
+			},
+		},
+	}
+}
+
+func (r *passwordDigitTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Password Digit Token ephemeral resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.data = data
+}
+
+func (r *passwordDigitTokenEphemeralResource) ValidateConfig(_ context.Context, _ ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	r.data.requireExperimental("sailpoint_password_digit_token", &resp.Diagnostics)
+}
+
+func (r *passwordDigitTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Info(ctx, "Opening Password Digit Token")
+	defer r.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state passwordDigitTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.data.client()
+	if !r.data.experimentalEnabled(client, "sailpoint_password_digit_token", &resp.Diagnostics) {
+		return
+	}
+
+	reset := api_v2025.NewPasswordDigitTokenReset(state.UserID.ValueString())
+	reset.Length = state.Length.ValueInt32Pointer()
+	reset.DurationMinutes = state.DurationMinutes.ValueInt32Pointer()
+
+	token, res, err := client.V2025.PasswordManagementAPI.CreateDigitToken(ctx).PasswordDigitTokenReset(*reset).Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Generate Password Digit Token", err, res)
+		return
+	}
+
+	state.DigitToken = types.StringPointerValue(token.DigitToken)
+	state.RequestID = types.StringPointerValue(token.RequestId)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &state)...)
+}
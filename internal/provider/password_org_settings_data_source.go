@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &passwordOrgSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &passwordOrgSettingsDataSource{}
+)
+
+func NewPasswordOrgSettingsDataSource() datasource.DataSource {
+	return &passwordOrgSettingsDataSource{}
+}
+
+type passwordOrgSettingsDataSource struct {
+	data *providerData
+}
+
+type passwordOrgSettingsDataSourceModel struct {
+	APIVersion                types.String `tfsdk:"api_version"`
+	CustomInstructionsEnabled types.Bool   `tfsdk:"custom_instructions_enabled"`
+	DigitTokenEnabled         types.Bool   `tfsdk:"digit_token_enabled"`
+	DigitTokenDurationMinutes types.Int32  `tfsdk:"digit_token_duration_minutes"`
+	DigitTokenLength          types.Int32  `tfsdk:"digit_token_length"`
+}
+
+func (d *passwordOrgSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_org_settings"
+}
+
+func (d *passwordOrgSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Password settings of the org, such as whether help desk digit tokens are enabled.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"custom_instructions_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether custom password instructions are shown to the users.",
+			},
+			"digit_token_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether digit tokens can be generated for password resets.",
+			},
+			"digit_token_duration_minutes": schema.Int32Attribute{
+				Computed:    true,
+				Description: "How long digit tokens are valid, in minutes.",
+			},
+			"digit_token_length": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Number of digits of the digit tokens.",
+			},
+		},
+	}
+}
+
+func (d *passwordOrgSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint PasswordOrgSettings data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *passwordOrgSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Password Org Settings")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state passwordOrgSettingsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, res, err := client.V2025.PasswordConfigurationAPI.GetPasswordOrgConfig(ctx).Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Password Org Settings", err, res)
+		return
+	}
+
+	state.CustomInstructionsEnabled = types.BoolPointerValue(settings.CustomInstructionsEnabled)
+	state.DigitTokenEnabled = types.BoolPointerValue(settings.DigitTokenEnabled)
+	state.DigitTokenDurationMinutes = types.Int32PointerValue(settings.DigitTokenDurationMinutes)
+	state.DigitTokenLength = types.Int32PointerValue(settings.DigitTokenLength)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+// peerGroupStrategyEntitlement is the only peer group strategy of the API,
+// grouping identities by their entitlements.
+const peerGroupStrategyEntitlement = "entitlement"
+
+// peerGroupStrategyPattern matches the peer group strategies of the API.
+var peerGroupStrategyPattern = regexp.MustCompile(`^entitlement$`)
+
+var (
+	_ datasource.DataSource                   = &peerGroupOutliersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &peerGroupOutliersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &peerGroupOutliersDataSource{}
+)
+
+func NewPeerGroupOutliersDataSource() datasource.DataSource {
+	return &peerGroupOutliersDataSource{}
+}
+
+type peerGroupOutliersDataSource struct {
+	data *providerData
+}
+
+type peerGroupOutliersDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Strategy types.String           `tfsdk:"strategy"`
+	Outliers []peerGroupMemberModel `tfsdk:"outliers"`
+}
+
+type peerGroupMemberModel struct {
+	ID          types.String        `tfsdk:"id"`
+	Type        types.String        `tfsdk:"type"`
+	PeerGroupID types.String        `tfsdk:"peer_group_id"`
+	Attributes  normalizedJSONValue `tfsdk:"attributes"`
+}
+
+func (d *peerGroupOutliersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer_group_outliers"
+}
+
+func (d *peerGroupOutliersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the identities IAI left out of the peer groups of a peer group strategy, for outlier context in compliance reporting. SailPoint deprecated the peer group strategies API in favor of IAI Outliers.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"strategy": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringPatternValidator{pattern: peerGroupStrategyPattern, message: "must be entitlement"}},
+				Description: "Strategy the peer groups are built with. Only entitlement is supported, which is the default.",
+			},
+			"outliers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Identities outside of the peer groups of the strategy.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the identity.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the object, IDENTITY.",
+						},
+						"peer_group_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the peer group of the identity, if any.",
+						},
+						"attributes": schema.StringAttribute{
+							CustomType:  normalizedJSONType{},
+							Computed:    true,
+							Description: "JSON encoded attributes of the identity in the peer group analysis.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *peerGroupOutliersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint PeerGroupOutliers data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// ValidateConfig rejects the data source at plan time when the provider
+// doesn't enable experimental APIs.
+func (d *peerGroupOutliersDataSource) ValidateConfig(_ context.Context, _ datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	d.data.requireExperimental("sailpoint_peer_group_outliers", &resp.Diagnostics)
+}
+
+func (d *peerGroupOutliersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Peer Group Outliers")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state peerGroupOutliersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !d.data.experimentalEnabled(client, "sailpoint_peer_group_outliers", &resp.Diagnostics) {
+		return
+	}
+
+	strategy := peerGroupStrategyEntitlement
+	if !state.Strategy.IsNull() {
+		strategy = state.Strategy.ValueString()
+	}
+
+	//nolint:staticcheck // Deprecated in favor of IAI Outliers, which has no strategies.
+	request := client.V2025.IAIPeerGroupStrategiesAPI.GetPeerGroupOutliers(ctx, strategy)
+	results, res, err := paginate[v2025.PeerGroupMember](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Peer Group Outliers", err, res)
+		return
+	}
+
+	state.Outliers = make([]peerGroupMemberModel, 0, len(results))
+	for _, member := range results {
+		attributes := normalizedJSONNull()
+		if member.Attributes != nil {
+			encoded, err := json.Marshal(member.Attributes)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Read Peer Group Outliers", err.Error())
+				return
+			}
+			attributes = normalizedJSONStringValue(string(encoded))
+		}
+		state.Outliers = append(state.Outliers, peerGroupMemberModel{
+			ID:          types.StringPointerValue(member.Id),
+			Type:        types.StringPointerValue(member.Type),
+			PeerGroupID: types.StringPointerValue(member.PeerGroupId),
+			Attributes:  attributes,
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
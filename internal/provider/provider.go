@@ -3,9 +3,18 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -14,11 +23,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &sailpointProvider{}
+	_ provider.Provider                       = &sailpointProvider{}
+	_ provider.ProviderWithFunctions          = &sailpointProvider{}
+	_ provider.ProviderWithEphemeralResources = &sailpointProvider{}
+	_ provider.ProviderWithActions            = &sailpointProvider{}
+	_ provider.ProviderWithListResources      = &sailpointProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -40,12 +54,61 @@ type sailpointProvider struct {
 
 // sailpointProviderModel maps provider schema data to a Go type.
 type sailpointProviderModel struct {
-	BaseUrl      types.String `tfsdk:"base_url"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	Experimental types.Bool   `tfsdk:"experimental"`
+	BaseUrl      types.String    `tfsdk:"base_url"`
+	BaseURLs     types.List      `tfsdk:"base_urls"`
+	Tenant       types.String    `tfsdk:"tenant"`
+	ClientID     types.String    `tfsdk:"client_id"`
+	ClientSecret types.String    `tfsdk:"client_secret"`
+	Experimental types.Bool      `tfsdk:"experimental"`
+	Label        types.String    `tfsdk:"label"`
+	AuthMethod   types.String    `tfsdk:"auth_method"`
+	Scopes       types.List      `tfsdk:"scopes"`
+	AccessToken  types.String    `tfsdk:"access_token"`
+	Environment  types.String    `tfsdk:"environment"`
+	RateLimit    *rateLimitModel `tfsdk:"rate_limit"`
+	Retry        *retryModel     `tfsdk:"retry"`
+
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+
+	ProxyURL      types.String `tfsdk:"proxy_url"`
+	CABundleFile  types.String `tfsdk:"ca_bundle_file"`
+	TLSMinVersion types.String `tfsdk:"tls_min_version"`
+
+	RequestTimeout         types.String `tfsdk:"request_timeout"`
+	TokenTimeout           types.String `tfsdk:"token_timeout"`
+	ReadAfterCreateTimeout types.String `tfsdk:"read_after_create_timeout"`
+	TokenCacheDir          types.String `tfsdk:"token_cache_dir"`
+
+	APIVersion types.String `tfsdk:"api_version"`
+
+	ValidateReferences types.Bool `tfsdk:"validate_references"`
+
+	UserAgentExtra types.String `tfsdk:"user_agent_extra"`
+	DebugHTTP      types.Bool   `tfsdk:"debug_http"`
 }
 
+// retryModel maps the retry provider block.
+type retryModel struct {
+	MaxAttempts          types.Int64  `tfsdk:"max_attempts"`
+	RetryableStatusCodes types.List   `tfsdk:"retryable_status_codes"`
+	RequestTimeout       types.String `tfsdk:"request_timeout"`
+}
+
+// rateLimitModel maps the rate_limit provider block.
+type rateLimitModel struct {
+	MaxRetries       types.Int64  `tfsdk:"max_retries"`
+	MaxElapsedTime   types.String `tfsdk:"max_elapsed_time"`
+	WarningThreshold types.Int64  `tfsdk:"warning_threshold"`
+}
+
+const (
+	// authMethodPAT authenticates with a personal access token.
+	authMethodPAT = "pat"
+	// authMethodClientCredentials authenticates with an OAuth client, requesting
+	// a token with the configured scopes.
+	authMethodClientCredentials = "client_credentials"
+)
+
 // Metadata returns the provider type name.
 func (p *sailpointProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "sailpoint"
@@ -55,23 +118,140 @@ func (p *sailpointProvider) Metadata(_ context.Context, _ provider.MetadataReque
 // Schema defines the provider-level schema for configuration data.
 func (p *sailpointProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Settings set in the provider configuration take precedence over the environment variables, which take precedence over the SailPoint CLI environment and the defaults. Settings left empty in the configuration fall back to their environment variable.",
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
 				Optional:    true,
-				Description: "The API URL - The API URL used to access your Identity Security Cloud tenant (ex. https://tenant.api.identitynow.com), this is used for the api calls made by certain commands.",
+				Description: "The API URL - The API URL used to access your Identity Security Cloud tenant (ex. https://tenant.api.identitynow.com), this is used for the api calls made by certain commands. May also be set with the SAIL_BASE_URL environment variable.",
+			},
+			"base_urls": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Base URLs of the regional API gateways of the tenant, tried in order when the previous one can't be reached. base_url defaults to the first one. Only requests which couldn't connect fail over, and the following requests keep going to the base URL which answered. May also be set with the SAIL_BASE_URLS environment variable, as a list separated by commas.",
+			},
+			"tenant": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Name of the tenant (ex. acme), from which base_url is derived when neither it nor base_urls are set, as %s. May also be set with the SAIL_TENANT environment variable.", fmt.Sprintf(tenantBaseURLFormat, "<tenant>")),
 			},
 			"client_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "The PAT Client ID https://developer.sailpoint.com/docs/api/authentication/#generate-a-personal-access-token",
+				Description: "The PAT Client ID https://developer.sailpoint.com/docs/api/authentication/#generate-a-personal-access-token. May also be set with the SAIL_CLIENT_ID environment variable.",
 			},
 			"client_secret": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "The PAT Client Secret https://developer.sailpoint.com/docs/api/authentication/#generate-a-personal-access-token",
+				Description: "The PAT Client Secret https://developer.sailpoint.com/docs/api/authentication/#generate-a-personal-access-token. May also be set with the SAIL_CLIENT_SECRET environment variable.",
 			},
 			"experimental": schema.BoolAttribute{
 				Optional:    true,
-				Description: "Whether it's allowed to use experimental resources",
+				Description: "Whether it's allowed to use experimental resources. May also be set with the SAIL_EXPERIMENTAL environment variable. Aliased provider blocks can set it independently, the environment variable applies to those which don't set it.",
+			},
+			"validate_references": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether resources check at plan time that the objects they reference by ID exist in the tenant, with a request per added or changed reference. References to objects created in the same apply are only known at apply time and aren't checked. Defaults to false. May also be set with the SAIL_VALIDATE_REFERENCES environment variable.",
+			},
+			"label": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of this provider configuration in diagnostics, usually the alias of the provider block since Terraform doesn't pass it to providers. Diagnostics name the tenant when omitted.",
+			},
+			"auth_method": schema.StringAttribute{
+				Optional:    true,
+				Description: "How to authenticate against the tenant, either `pat` (default) for personal access tokens or `client_credentials` for OAuth clients. May also be set with the SAIL_AUTH_METHOD environment variable.",
+			},
+			"scopes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Scopes requested for the access token when auth_method is `client_credentials`. The client's default scopes are used when omitted. May also be set with the SAIL_SCOPES environment variable, as a list separated by spaces or commas.",
+			},
+			"access_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "A pre-acquired access token used instead of client credentials, for tokens minted outside of Terraform (ex. by Vault in CI). The token is not refreshed, so it must outlive the run. May also be set with the SAIL_ACCESS_TOKEN environment variable.",
+			},
+			"environment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a tenant environment defined in the SailPoint CLI configuration file (~/.sailpoint/config.yaml). Its base URL and PAT are used for any value not set in the configuration or environment variables. May also be set with the SAIL_ENVIRONMENT environment variable.",
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "How requests rejected with 429 Too Many Requests are retried. The Retry-After header is honored when present, otherwise the wait doubles on every attempt.",
+				Attributes: map[string]schema.Attribute{
+					"max_retries": schema.Int64Attribute{
+						Optional:    true,
+						Description: fmt.Sprintf("Maximum number of retries of a rate limited request. Defaults to %d. May also be set with the SAIL_RATE_LIMIT_MAX_RETRIES environment variable.", defaultRateLimitMaxRetries),
+					},
+					"max_elapsed_time": schema.StringAttribute{
+						Optional:    true,
+						Description: fmt.Sprintf("Maximum time spent retrying a rate limited request, as a duration (ex. 90s, 5m). Defaults to %s. May also be set with the SAIL_RATE_LIMIT_MAX_ELAPSED_TIME environment variable.", defaultRateLimitMaxElapsedTime),
+					},
+					"warning_threshold": schema.Int64Attribute{
+						Optional:    true,
+						Description: fmt.Sprintf("Share of the tenant rate limit, in percent, past which a warning is shown, from the X-RateLimit-Limit and X-RateLimit-Remaining response headers. Requests rejected with 429 Too Many Requests always cause the warning. Defaults to %d, 0 disables the warning. May also be set with the SAIL_RATE_LIMIT_WARNING_THRESHOLD environment variable.", defaultRateLimitWarningThreshold),
+					},
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests in flight at once, shared by every data source and resource. Useful to stay under the tenant rate limits with large configurations. Unlimited by default. May also be set with the SAIL_MAX_CONCURRENT_REQUESTS environment variable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy used to reach the tenant (ex. http://proxy.example.com:3128). May also be set with the SAIL_PROXY_URL environment variable. The HTTPS_PROXY and NO_PROXY environment variables are honored when neither is set.",
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM file with certificates trusted in addition to the system ones, for proxies inspecting TLS traffic. May also be set with the SAIL_CA_BUNDLE_FILE environment variable.",
+			},
+			"tls_min_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum TLS version accepted when connecting to the tenant, one of 1.0, 1.1, 1.2 or 1.3. May also be set with the SAIL_TLS_MIN_VERSION environment variable.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum duration of an API call, including its retries (ex. 90s, 5m). Defaults to %s. May also be set with the SAIL_REQUEST_TIMEOUT environment variable.", defaultRequestTimeout),
+			},
+			"token_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum duration of the access token request (ex. 10s, 1m). Defaults to %s. May also be set with the SAIL_TOKEN_TIMEOUT environment variable.", defaultTokenTimeout),
+			},
+			"read_after_create_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("How long resources keep reading an object they just created, or which they reference and may have been created in the same apply, while the API reports it as not found, as it can briefly do (ex. 30s, 5m). Defaults to %s. May also be set with the SAIL_READ_AFTER_CREATE_TIMEOUT environment variable.", defaultReadAfterCreateTimeout),
+			},
+			"api_version": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("API version used to reach the tenant, one of %s. Defaults to %s, use an older version for tenants which have not enabled the newer ones. Data sources can override it with their own api_version, which those whose endpoints the version doesn't expose must set. May also be set with the SAIL_API_VERSION environment variable.", strings.Join(apiVersions, ", "), defaultAPIVersion),
+			},
+			"user_agent_extra": schema.StringAttribute{
+				Optional:    true,
+				Description: "Text appended to the User-Agent of every request, to identify the pipeline or team running Terraform. May also be set with the SAIL_USER_AGENT_EXTRA environment variable.",
+			},
+			"debug_http": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to log every API request and response (method, URL, status, rate limit headers and bodies) at debug level, with Authorization headers and secret fields redacted. Logs are shown with TF_LOG=DEBUG. May also be set with the SAIL_DEBUG_HTTP environment variable.",
+			},
+			"token_cache_dir": schema.StringAttribute{
+				Optional:    true,
+				Description: "Directory in which access tokens are cached between Terraform runs, so repeated plans don't request new tokens. Tokens are only cached in memory, for the provider instances of a single run, when omitted. May also be set with the SAIL_TOKEN_CACHE_DIR environment variable.",
+			},
+			"retry": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "How requests failing with transient errors (connection failures, timeouts and gateway errors) are retried.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Optional:    true,
+						Description: fmt.Sprintf("Maximum number of times a request is sent, including the first attempt. Defaults to %d, 1 disables retries. May also be set with the SAIL_RETRY_MAX_ATTEMPTS environment variable.", defaultRetryMaxAttempts),
+					},
+					"retryable_status_codes": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Description: "HTTP status codes considered transient. Defaults to 502, 503 and 504. May also be set with the SAIL_RETRY_STATUS_CODES environment variable, as a list separated by commas.",
+					},
+					"request_timeout": schema.StringAttribute{
+						Optional:    true,
+						Description: "Maximum duration of a single attempt (ex. 30s, 2m). Attempts are not bounded by default. May also be set with the SAIL_RETRY_REQUEST_TIMEOUT environment variable.",
+					},
+				},
 			},
 		},
 	}
@@ -102,6 +282,15 @@ func (p *sailpointProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.BaseURLs.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_urls"),
+			"Unknown SailPoint API Base URLs",
+			"The provider cannot create the SailPoint API client as there is an unknown configuration value for the SailPoint API base URLs. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SAIL_BASE_URLS environment variable.",
+		)
+	}
+
 	if config.ClientID.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_id"),
@@ -120,34 +309,118 @@ func (p *sailpointProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown SailPoint access_token",
+			"The provider cannot create the SailPoint API client as there is an unknown configuration value for the SailPoint access token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SAIL_ACCESS_TOKEN environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
+	// with Terraform configuration value if set. Empty configuration
+	// values are ignored so modules can pass through unset variables
+	// while the credentials come from the environment.
+
+	baseUrl := configOrEnv(config.BaseUrl, "SAIL_BASE_URL")
+	clientID := configOrEnv(config.ClientID, "SAIL_CLIENT_ID")
+	clientSecret := configOrEnv(config.ClientSecret, "SAIL_CLIENT_SECRET")
+	authMethod := configOrEnv(config.AuthMethod, "SAIL_AUTH_METHOD")
+	accessToken := configOrEnv(config.AccessToken, "SAIL_ACCESS_TOKEN")
+
+	// Values still missing are read from the selected SailPoint CLI
+	// environment, so credentials can be shared with the CLI.
+	if environmentName := configOrEnv(config.Environment, "SAIL_ENVIRONMENT"); environmentName != "" {
+		cliConfigPath, err := defaultCLIConfigPath()
+		var environment cliEnvironment
+		if err == nil {
+			environment, err = readCLIEnvironment(cliConfigPath, environmentName)
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("environment"),
+				"Unable to Read SailPoint CLI Configuration",
+				"The provider cannot read the selected environment from the SailPoint CLI configuration file: "+err.Error(),
+			)
+			return
+		}
 
-	baseUrl := os.Getenv("SAIL_BASE_URL")
-	clientID := os.Getenv("SAIL_CLIENT_ID")
-	clientSecret := os.Getenv("SAIL_CLIENT_SECRET")
-	experimental := os.Getenv("SAIL_EXPERIMENTAL") == "true"
+		tflog.Debug(ctx, "Using SailPoint CLI environment", map[string]any{"environment": environmentName, "path": cliConfigPath})
 
-	tflog.Debug(ctx, fmt.Sprintf("baseurl from env: %s", baseUrl))
+		if baseUrl == "" {
+			baseUrl = environment.BaseURL
+		}
+		if clientID == "" {
+			clientID = environment.Pat.ClientID
+		}
+		if clientSecret == "" {
+			clientSecret = environment.Pat.ClientSecret
+		}
+	}
 
-	if !config.BaseUrl.IsNull() {
-		baseUrl = config.BaseUrl.ValueString()
+	experimental, err := boolConfigOrEnv(config.Experimental, "SAIL_EXPERIMENTAL")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("experimental"),
+			"Invalid SailPoint experimental",
+			"The SAIL_EXPERIMENTAL environment variable must be true or false.",
+		)
 	}
 
-	if !config.ClientID.IsNull() {
-		clientID = config.ClientID.ValueString()
+	debugHTTP, err := boolConfigOrEnv(config.DebugHTTP, "SAIL_DEBUG_HTTP")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("debug_http"),
+			"Invalid SailPoint debug_http",
+			"The SAIL_DEBUG_HTTP environment variable must be true or false.",
+		)
 	}
 
-	if !config.ClientSecret.IsNull() {
-		clientSecret = config.ClientSecret.ValueString()
+	validateReferences, err := boolConfigOrEnv(config.ValidateReferences, "SAIL_VALIDATE_REFERENCES")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validate_references"),
+			"Invalid SailPoint validate_references",
+			"The SAIL_VALIDATE_REFERENCES environment variable must be true or false.",
+		)
 	}
 
-	if !config.Experimental.IsNull() {
-		experimental = config.Experimental.ValueBool()
+	// The base URL falls back to the first failover base URL, then to the
+	// one of the tenant.
+	failover := make([]string, 0)
+	if !config.BaseURLs.IsNull() {
+		resp.Diagnostics.Append(config.BaseURLs.ElementsAs(ctx, &failover, false)...)
+	} else {
+		failover = splitEnvList(os.Getenv("SAIL_BASE_URLS"))
+	}
+	if baseUrl == "" && len(failover) > 0 {
+		baseUrl = failover[0]
+	}
+	if tenant := configOrEnv(config.Tenant, "SAIL_TENANT"); baseUrl == "" && tenant != "" {
+		baseUrl = fmt.Sprintf(tenantBaseURLFormat, tenant)
+	}
+
+	tflog.Debug(ctx, "Resolved SailPoint provider configuration", map[string]any{
+		"base_url_set":      baseUrl != "",
+		"client_id_set":     clientID != "",
+		"client_secret_set": clientSecret != "",
+		"access_token_set":  accessToken != "",
+	})
+
+	if authMethod == "" {
+		authMethod = authMethodPAT
+	}
+
+	scopes := make([]string, 0)
+	if !config.Scopes.IsNull() {
+		resp.Diagnostics.Append(config.Scopes.ElementsAs(ctx, &scopes, false)...)
+	} else {
+		scopes = splitEnvList(os.Getenv("SAIL_SCOPES"))
 	}
 
 	// If any of the expected configurations are missing, return
@@ -158,60 +431,393 @@ func (p *sailpointProvider) Configure(ctx context.Context, req provider.Configur
 			path.Root("base_url"),
 			"Missing SailPoint API base_url",
 			"The provider cannot create the SailPoint API client as there is a missing or empty value for the SailPoint API Base URL. "+
-				"Set the base_url value in the configuration or use the SAIL_BASE_URL environment variable. "+
+				"Set the base_url, base_urls or tenant value in the configuration or use the SAIL_BASE_URL, SAIL_BASE_URLS or SAIL_TENANT environment variables. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if clientID == "" {
+	// Client credentials are only needed to request tokens, which a
+	// pre-acquired access token makes unnecessary.
+
+	if clientID == "" && accessToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_id"),
 			"Missing SailPoint PAT client_id",
 			"The provider cannot create the SailPoint API client as there is a missing or empty value for the SailPoint PAT Client ID. "+
-				"Set the client_id value in the configuration or use the SAIL_CLIENT_ID environment variable. "+
+				"Set the client_id value in the configuration or use the SAIL_CLIENT_ID environment variable, or provide an access_token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if clientSecret == "" {
+	if clientSecret == "" && accessToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_secret"),
 			"Missing SailPoint PAT client_secret",
 			"The provider cannot create the SailPoint API client as there is a missing or empty value for the SailPoint PAT Client secret. "+
-				"Set the client_secret value in the configuration or use the SAIL_CLIENT_SECRET environment variable. "+
+				"Set the client_secret value in the configuration or use the SAIL_CLIENT_SECRET environment variable, or provide an access_token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	if authMethod != authMethodPAT && authMethod != authMethodClientCredentials {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_method"),
+			"Invalid SailPoint auth_method",
+			fmt.Sprintf("The provider cannot create the SailPoint API client as the auth_method %q is not supported. "+
+				"Use either %q or %q.", authMethod, authMethodPAT, authMethodClientCredentials),
+		)
+	}
+
+	if authMethod == authMethodPAT && len(scopes) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scopes"),
+			"Unsupported SailPoint scopes",
+			"Scopes can only be requested when auth_method is \"client_credentials\", personal access tokens always carry the scopes they were created with.",
+		)
+	}
+
+	retry := retrySettings{
+		RateLimitMaxRetries:     defaultRateLimitMaxRetries,
+		RateLimitMaxElapsedTime: defaultRateLimitMaxElapsedTime,
+		MaxAttempts:             defaultRetryMaxAttempts,
+		RetryableStatusCodes:    defaultRetryableStatusCodes(),
+	}
+	// The nested blocks are read as empty when absent, so their settings
+	// still fall back to the environment variables.
+	if config.RateLimit == nil {
+		config.RateLimit = &rateLimitModel{}
+	}
+	if config.Retry == nil {
+		config.Retry = &retryModel{}
+	}
+
+	if maxRetries, ok, err := int64ConfigOrEnv(config.RateLimit.MaxRetries, "SAIL_RATE_LIMIT_MAX_RETRIES"); ok {
+		retry.RateLimitMaxRetries = int(maxRetries)
+		if err != nil || retry.RateLimitMaxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rate_limit").AtName("max_retries"),
+				"Invalid SailPoint rate_limit max_retries",
+				"The maximum number of retries must be a positive number or 0.",
+			)
+		}
+	}
+
+	if value := configOrEnv(config.RateLimit.MaxElapsedTime, "SAIL_RATE_LIMIT_MAX_ELAPSED_TIME"); value != "" {
+		maxElapsedTime, err := time.ParseDuration(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rate_limit").AtName("max_elapsed_time"),
+				"Invalid SailPoint rate_limit max_elapsed_time",
+				"The maximum elapsed time must be a duration (ex. 90s, 5m): "+err.Error(),
+			)
+		}
+		retry.RateLimitMaxElapsedTime = maxElapsedTime
+	}
+
+	rateLimitWarningThreshold := defaultRateLimitWarningThreshold
+	if threshold, ok, err := int64ConfigOrEnv(config.RateLimit.WarningThreshold, "SAIL_RATE_LIMIT_WARNING_THRESHOLD"); ok {
+		rateLimitWarningThreshold = int(threshold)
+		if err != nil || threshold < 0 || threshold > 100 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rate_limit").AtName("warning_threshold"),
+				"Invalid SailPoint rate_limit warning_threshold",
+				"The warning threshold must be a percentage between 0 and 100.",
+			)
+		}
+	}
+
+	if maxAttempts, ok, err := int64ConfigOrEnv(config.Retry.MaxAttempts, "SAIL_RETRY_MAX_ATTEMPTS"); ok {
+		retry.MaxAttempts = int(maxAttempts)
+		if err != nil || retry.MaxAttempts < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry").AtName("max_attempts"),
+				"Invalid SailPoint retry max_attempts",
+				"Requests are sent at least once, the maximum number of attempts must be 1 or more.",
+			)
+		}
+	}
+
+	if !config.Retry.RetryableStatusCodes.IsNull() {
+		statusCodes := make([]int, 0)
+		resp.Diagnostics.Append(config.Retry.RetryableStatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+		retry.RetryableStatusCodes = statusCodes
+	} else if value := os.Getenv("SAIL_RETRY_STATUS_CODES"); value != "" {
+		statusCodes := make([]int, 0)
+		for _, code := range splitEnvList(value) {
+			statusCode, err := strconv.Atoi(code)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("retry").AtName("retryable_status_codes"),
+					"Invalid SailPoint retry retryable_status_codes",
+					fmt.Sprintf("The SAIL_RETRY_STATUS_CODES environment variable must list HTTP status codes separated by commas, got %q.", value),
+				)
+				break
+			}
+			statusCodes = append(statusCodes, statusCode)
+		}
+		retry.RetryableStatusCodes = statusCodes
+	}
+
+	if value := configOrEnv(config.Retry.RequestTimeout, "SAIL_RETRY_REQUEST_TIMEOUT"); value != "" {
+		requestTimeout, err := time.ParseDuration(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry").AtName("request_timeout"),
+				"Invalid SailPoint retry request_timeout",
+				"The request timeout must be a duration (ex. 30s, 2m): "+err.Error(),
+			)
+		}
+		retry.RequestTimeout = requestTimeout
+	}
+
+	maxConcurrentRequests := 0
+	if value, ok, err := int64ConfigOrEnv(config.MaxConcurrentRequests, "SAIL_MAX_CONCURRENT_REQUESTS"); ok {
+		maxConcurrentRequests = int(value)
+		if err != nil || maxConcurrentRequests < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrent_requests"),
+				"Invalid SailPoint max_concurrent_requests",
+				"At least one request must be allowed in flight.",
+			)
+		}
+	}
+
+	var baseURLs []*url.URL
+	if baseUrl != "" {
+		var err error
+		baseURLs, err = failoverBaseURLs(baseUrl, failover)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("base_urls"),
+				"Invalid SailPoint base_urls",
+				"The provider cannot create the SailPoint API client as "+err.Error()+".",
+			)
+		}
+	}
+
+	var connection connectionSettings
+	if proxyURL := configOrEnv(config.ProxyURL, "SAIL_PROXY_URL"); proxyURL != "" {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid SailPoint proxy_url",
+				fmt.Sprintf("The proxy URL %q must be an absolute URL (ex. http://proxy.example.com:3128).", proxyURL),
+			)
+		}
+		connection.ProxyURL = parsed
+	}
+
+	if caBundleFile := configOrEnv(config.CABundleFile, "SAIL_CA_BUNDLE_FILE"); caBundleFile != "" {
+		caBundle, err := os.ReadFile(caBundleFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_bundle_file"),
+				"Unable to Read SailPoint ca_bundle_file",
+				err.Error(),
+			)
+		}
+		connection.CABundle = caBundle
+	}
+
+	if tlsMinVersion := configOrEnv(config.TLSMinVersion, "SAIL_TLS_MIN_VERSION"); tlsMinVersion != "" {
+		version, ok := tlsVersions[tlsMinVersion]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tls_min_version"),
+				"Invalid SailPoint tls_min_version",
+				fmt.Sprintf("The TLS version %q is not supported, use one of 1.0, 1.1, 1.2 or 1.3.", tlsMinVersion),
+			)
+		}
+		connection.TLSMinVersion = version
+	}
+
+	requestTimeout := defaultRequestTimeout
+	if value := configOrEnv(config.RequestTimeout, "SAIL_REQUEST_TIMEOUT"); value != "" {
+		var err error
+		requestTimeout, err = time.ParseDuration(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid SailPoint request_timeout",
+				"The request timeout must be a duration (ex. 90s, 5m): "+err.Error(),
+			)
+		}
+	}
+
+	tokenTimeout := defaultTokenTimeout
+	if value := configOrEnv(config.TokenTimeout, "SAIL_TOKEN_TIMEOUT"); value != "" {
+		var err error
+		tokenTimeout, err = time.ParseDuration(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_timeout"),
+				"Invalid SailPoint token_timeout",
+				"The token timeout must be a duration (ex. 10s, 1m): "+err.Error(),
+			)
+		}
+	}
+
+	readAfterCreateTimeout := defaultReadAfterCreateTimeout
+	if value := configOrEnv(config.ReadAfterCreateTimeout, "SAIL_READ_AFTER_CREATE_TIMEOUT"); value != "" {
+		var err error
+		readAfterCreateTimeout, err = time.ParseDuration(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_after_create_timeout"),
+				"Invalid SailPoint read_after_create_timeout",
+				"The read after create timeout must be a duration (ex. 30s, 5m): "+err.Error(),
+			)
+		}
+	}
+
+	apiVersion := configOrEnv(config.APIVersion, "SAIL_API_VERSION")
+	if apiVersion == "" {
+		apiVersion = defaultAPIVersion
+	}
+	if !validAPIVersion(apiVersion) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_version"),
+			"Invalid SailPoint api_version",
+			fmt.Sprintf("The API version %q is not supported, use one of %s.", apiVersion, strings.Join(apiVersions, ", ")),
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	ctx = tflog.SetField(ctx, "sailpoint_base_url", baseUrl)
-	ctx = tflog.SetField(ctx, "sailpoint_client_id", clientID)
-	ctx = tflog.SetField(ctx, "sailpoint_client_secret", clientSecret)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "sailpoint_client_secret")
+	ctx = tflog.SetField(ctx, "sailpoint_api_version", apiVersion)
+	ctx = tflog.SetField(ctx, "sailpoint_auth_method", authMethod)
+
+	// Credentials are never logged as fields, the masks only catch them if
+	// they end up in a message or in an error returned by a dependency.
+	for _, secret := range []string{clientSecret, accessToken} {
+		if secret != "" {
+			ctx = tflog.MaskMessageStrings(ctx, secret)
+			ctx = tflog.MaskAllFieldValuesStrings(ctx, secret)
+		}
+	}
 
 	tflog.Debug(ctx, "Creating SailPoint API client")
 
-	// Create a new SailPoint client using the configuration values
-	configuration := sailpoint.NewConfiguration(sailpoint.ClientConfiguration{
-		BaseURL:      baseUrl,
-		ClientId:     clientID,
-		ClientSecret: clientSecret,
-		TokenURL:     fmt.Sprintf("%s/oauth/token", baseUrl), // token URL seems to be required when passing the parameters to the client configuration
+	// Every request of this run carries the same correlation ID, so support
+	// can find the requests of a failed run from its logs.
+	correlationID := newCorrelationID()
+	tflog.Info(ctx, "SailPoint API requests of this run are sent with a correlation ID", map[string]any{"correlation_id": correlationID})
+
+	rateLimit := newRateLimitUsage(rateLimitWarningThreshold)
+	httpClient, err := newHTTPClient(httpClientSettings{
+		Retry:                 retry,
+		Connection:            connection,
+		MaxConcurrentRequests: maxConcurrentRequests,
+		RequestTimeout:        requestTimeout,
+		UserAgentExtra:        configOrEnv(config.UserAgentExtra, "SAIL_USER_AGENT_EXTRA"),
+		CorrelationID:         correlationID,
+		DebugHTTP:             debugHTTP,
+		RateLimitUsage:        rateLimit,
+		BaseURLs:              baseURLs,
 	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create SailPoint API Client",
+			"The provider could not configure the HTTP client: "+err.Error(),
+		)
+		return
+	}
+
+	tokenURL := fmt.Sprintf("%s/oauth/token", baseUrl) // token URL seems to be required when passing the parameters to the client configuration
+
+	// The token is requested here instead of letting the SDK request it, so
+	// the request goes through the configured proxy and TLS settings, OAuth
+	// clients get the scopes they are restricted to, tokens are shared
+	// between provider instances, and invalid credentials surface as a
+	// configuration error.
+	data := &providerData{
+		clients:      make(map[string]*sailpoint.APIClient, len(apiVersions)),
+		apiVersion:   apiVersion,
+		accessToken:  accessToken,
+		httpClient:   httpClient.StandardClient(),
+		tokenTimeout: tokenTimeout,
+		cache:        newResponseCache(),
+		label:        providerLabel(config.Label, baseUrl),
+		rateLimit:    rateLimit,
+
+		readAfterCreateTimeout: readAfterCreateTimeout,
+		validateReferences:     validateReferences,
+	}
+
+	var tokens tokenSource = staticTokenSource{accessToken: accessToken}
+	if accessToken != "" {
+		tflog.Debug(ctx, "Using pre-acquired SailPoint access token")
+	} else {
+		tflog.Debug(ctx, "Requesting SailPoint access token", map[string]any{"scopes": strings.Join(scopes, " ")})
+
+		oauthConfig := clientcredentials.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			TokenURL:     tokenURL,
+			Scopes:       scopes,
+		}
+		data.oauthConfig = &oauthConfig
+		refreshing := &refreshingTokenSource{
+			config:     oauthConfig,
+			cacheDir:   configOrEnv(config.TokenCacheDir, "SAIL_TOKEN_CACHE_DIR"),
+			httpClient: data.httpClient,
+			timeout:    tokenTimeout,
+		}
+		if _, err := refreshing.token(ctx); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Authenticate SailPoint API Client",
+				"The provider could not obtain an access token with the configured client credentials: "+err.Error(),
+			)
+			return
+		}
+		tokens = refreshing
+	}
+	// The SDK is given no credentials, authTransport sets the token of
+	// every request instead so it is refreshed as it expires.
+	apiHTTPClient := authenticatedHTTPClient(httpClient, tokens)
+
+	// Create a new SailPoint client per API version using the configuration
+	// values
+	for _, version := range apiVersions {
+		configuration := sailpoint.NewConfiguration(sailpoint.ClientConfiguration{
+			BaseURL:  baseUrl,
+			TokenURL: tokenURL,
+		})
+		configuration.ConsumerIdentifier = "terraform-provider-sailpoint"
+		configuration.ConsumerVersion = p.version
+		configuration.Experimental = experimental
+		configuration.HTTPClient = apiHTTPClient
+		// The SDK debug output dumps requests with their Authorization
+		// header, debug_http logs them with secrets redacted instead.
+		configuration.Debug = false
+
+		client := sailpoint.NewAPIClient(configuration)
+		targetAPIVersion(client, baseUrl, version)
+		data.clients[version] = client
+	}
 	if experimental {
-		configuration.Experimental = true
 		tflog.Debug(ctx, "Allowing the client to use experimental resources")
 	}
-	configuration.Debug = true
-	client := sailpoint.NewAPIClient(configuration)
 
-	// Make the SailPoint client available during DataSource and Resource
+	// Make the SailPoint clients available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
+	resp.ActionData = data
+}
+
+// configOrEnv returns the configured value when it is set and not empty,
+// falling back to the given environment variable otherwise.
+func configOrEnv(value types.String, key string) string {
+	if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+		return value.ValueString()
+	}
+	return os.Getenv(key)
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -219,6 +825,41 @@ func (p *sailpointProvider) DataSources(_ context.Context) []func() datasource.D
 	return []func() datasource.DataSource{
 		NewManagedClustersDataSource,
 		NewManagedClusterDataSource,
+		NewNonEmployeeRecordsDataSource,
+		NewNonEmployeeSourcesDataSource,
+		NewNonEmployeeApprovalsDataSource,
+		NewReportsDataSource,
+		NewReportResultDataSource,
+		NewSuggestedEntitlementDescriptionsDataSource,
+		NewIdentityAttributesDataSource,
+		NewLaunchersDataSource,
+		NewSavedSearchesDataSource,
+		NewScheduledSearchesDataSource,
+		NewSpConfigExportDataSource,
+		NewSpConfigImportPreviewDataSource,
+		NewSpConfigObjectTypesDataSource,
+		NewConfigurationHubBackupsDataSource,
+		NewConfigurationHubDraftsDataSource,
+		NewConnectorRulesDataSource,
+		NewAppsDataSource,
+		NewDimensionsDataSource,
+		NewMachineIdentitiesDataSource,
+		NewMachineAccountsDataSource,
+		NewHealthDataSource,
+		NewPasswordOrgSettingsDataSource,
+		NewSourcePeekObjectsDataSource,
+		NewSourceSchemasDataSource,
+		NewSearchAggregationDataSource,
+		NewServiceDeskIntegrationTypesDataSource,
+		NewServiceDeskIntegrationTemplateDataSource,
+		NewProvisioningCompletionDataSource,
+		NewRoleMiningSessionsDataSource,
+		NewRoleMiningPotentialRolesDataSource,
+		NewPeerGroupOutliersDataSource,
+		NewRecommendationsDataSource,
+		NewTenantUsageDataSource,
+		NewManagedClusterSourcesDataSource,
+		NewManagedClientsDataSource,
 	}
 }
 
@@ -226,5 +867,102 @@ func (p *sailpointProvider) DataSources(_ context.Context) []func() datasource.D
 func (p *sailpointProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewManagedClusterResource,
+		NewEntitlementBulkUpdateResource,
+		NewTagAssignmentSetResource,
+		NewIdentityProfilePrioritiesResource,
+		NewSourceAttributeSyncResource,
+		NewRoleMembershipResource,
+		NewSourceConnectionTestResource,
+		NewSourceEntitlementAggregationResource,
+		NewSourceAccountAggregationResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *sailpointProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewFilterFunction,
+		NewValidateTransformFunction,
+		NewNormalizeJSONFunction,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the
+// provider.
+func (p *sailpointProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+		NewPasswordDigitTokenEphemeralResource,
 	}
 }
+
+// Actions defines the actions implemented in the provider.
+func (p *sailpointProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewCertificationReassignAction,
+		NewIdentityProcessingAction,
+		NewAccountStateAction,
+		NewPasswordChangeAction,
+		NewIdentityLifecycleStateAction,
+		NewIdentitiesInviteAction,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider, which
+// list the objects of the resources of the same name.
+func (p *sailpointProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewManagedClusterListResource,
+		NewRoleMembershipListResource,
+		NewSourceAttributeSyncListResource,
+		NewIdentityProfilePrioritiesListResource,
+		NewTagAssignmentSetListResource,
+	}
+}
+
+// providerLabel returns how diagnostics name a provider configuration: its
+// label, or the tenant it targets.
+func providerLabel(label types.String, baseURL string) string {
+	if label.ValueString() != "" {
+		return fmt.Sprintf("%q", label.ValueString())
+	}
+	return "for " + baseURL
+}
+
+// int64ConfigOrEnv is configOrEnv for integer settings. ok is false when
+// neither the configuration nor the environment variable sets the value, and
+// err is set when the environment variable is not an integer.
+func int64ConfigOrEnv(value types.Int64, key string) (int64, bool, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueInt64(), true, nil
+	}
+
+	env := os.Getenv(key)
+	if env == "" {
+		return 0, false, nil
+	}
+	parsed, err := strconv.ParseInt(env, 10, 64)
+	return parsed, true, err
+}
+
+// boolConfigOrEnv is configOrEnv for boolean settings, which are false when
+// unset. err is set when the environment variable is not a boolean.
+func boolConfigOrEnv(value types.Bool, key string) (bool, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), nil
+	}
+
+	env := os.Getenv(key)
+	if env == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(env)
+}
+
+// splitEnvList splits an environment variable holding a list, whose items are
+// separated by commas or spaces.
+func splitEnvList(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+}
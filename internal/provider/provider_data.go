@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	apiVersionV3    = "v3"
+	apiVersionV2024 = "v2024"
+	apiVersionV2025 = "v2025"
+
+	defaultAPIVersion = apiVersionV2025
+)
+
+// apiVersions lists the API versions the provider can target.
+var apiVersions = []string{apiVersionV3, apiVersionV2024, apiVersionV2025}
+
+// apiVersionsSinceV2024 lists the API versions exposing the endpoints which
+// were added after v3.
+var apiVersionsSinceV2024 = []string{apiVersionV2024, apiVersionV2025}
+
+// apiVersionDataSourceSchemaAttribute returns the api_version attribute of a
+// data source whose endpoints are exposed by the given API versions. The
+// services of the SDK are only generated for v2025, so a version which lacks
+// one of the endpoints would answer with a 404 or a different payload.
+func apiVersionDataSourceSchemaAttribute(versions []string) dataSchema.StringAttribute {
+	return dataSchema.StringAttribute{
+		Optional:    true,
+		Validators:  []validator.String{stringOneOfValidator{values: versions}},
+		Description: "API version used by this data source (" + strings.Join(versions, ", ") + "), overriding the provider's api_version for endpoints a tenant only exposes in another version.",
+	}
+}
+
+// providerData is handed to data sources and resources when the provider is
+// configured.
+type providerData struct {
+	// clients holds an SDK client per API version. The SDK models are shared
+	// by every version, so the V2025 services of each client are pointed at
+	// the path of the version it targets. Data sources only accept the
+	// versions exposing their endpoints, see clientFor.
+	clients    map[string]*sailpoint.APIClient
+	apiVersion string
+
+	// oauthConfig holds the client credentials, it is nil when the provider
+	// was configured with a pre-acquired access_token.
+	oauthConfig  *clientcredentials.Config
+	accessToken  string
+	httpClient   *http.Client
+	tokenTimeout time.Duration
+
+	// label names the provider configuration in diagnostics, see
+	// providerLabel.
+	label string
+
+	// rateLimit tracks the rate limit usage of the requests, see
+	// rateLimitUsage.warnRateLimit.
+	rateLimit *rateLimitUsage
+
+	// cache is shared by the data sources of the run.
+	cache *responseCache
+
+	// readAfterCreateTimeout bounds the reads of resources waiting for the
+	// objects they created to be visible, see readAfterCreate.
+	readAfterCreateTimeout time.Duration
+
+	// validateReferences enables the plan time checks of the objects
+	// resources reference, see checkReferences.
+	validateReferences bool
+}
+
+// client returns the client for the API version selected in the provider
+// configuration.
+func (p *providerData) client() *sailpoint.APIClient {
+	return p.clients[p.apiVersion]
+}
+
+// clientFor returns the client for the API version a data source selected,
+// falling back to the provider's when it did not select one. versions are
+// the API versions exposing the endpoints of the data source, see
+// apiVersionDataSourceSchemaAttribute.
+func (p *providerData) clientFor(version types.String, versions []string, diags *diag.Diagnostics) *sailpoint.APIClient {
+	if version.IsNull() || version.IsUnknown() || version.ValueString() == "" {
+		if !slices.Contains(versions, p.apiVersion) {
+			diags.AddAttributeError(
+				path.Root("api_version"),
+				"Unsupported SailPoint API Version",
+				fmt.Sprintf("The API version %q of the provider doesn't expose the endpoints of this data source, set its api_version to one of %s.", p.apiVersion, strings.Join(versions, ", ")),
+			)
+			return nil
+		}
+		return p.client()
+	}
+
+	client, ok := p.clients[version.ValueString()]
+	if !ok || !slices.Contains(versions, version.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("api_version"),
+			"Unsupported SailPoint API Version",
+			fmt.Sprintf("The API version %q is not supported by this data source, use one of %s.", version.ValueString(), strings.Join(versions, ", ")),
+		)
+		return nil
+	}
+
+	return client
+}
+
+// targetAPIVersion points the V2025 services of the client at another API
+// version.
+func targetAPIVersion(client *sailpoint.APIClient, baseURL string, version string) {
+	config := client.V2025.GetConfig()
+	for i := range config.Servers {
+		config.Servers[i].URL = baseURL + "/" + version
+	}
+}
+
+func validAPIVersion(version string) bool {
+	return slices.Contains(apiVersions, version)
+}
+
+// newAccessToken requests a new access token restricted to the given scopes,
+// the client's default scopes being used when none are given. Without client
+// credentials, only the configured access token can be handed out.
+func (p *providerData) newAccessToken(ctx context.Context, scopes []string) (*oauth2.Token, error) {
+	if p.oauthConfig == nil {
+		if len(scopes) > 0 {
+			return nil, errors.New("the provider is configured with an access_token, tokens with other scopes require client credentials")
+		}
+		return &oauth2.Token{AccessToken: p.accessToken, TokenType: "Bearer"}, nil
+	}
+
+	config := *p.oauthConfig
+	config.Scopes = scopes
+
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, oauth2.HTTPClient, p.httpClient), p.tokenTimeout)
+	defer cancel()
+
+	return config.Token(ctx)
+}
+
+// experimentalEnabled reports whether the provider allows experimental APIs,
+// adding an error diagnostic when it does not. The SDK panics when an
+// experimental endpoint is called without opting in, so data sources and
+// resources backed by one must check this before sending the request. The
+// diagnostic names the provider configuration, since aliased provider blocks
+// may enable experimental APIs for some tenants only.
+func (p *providerData) experimentalEnabled(client *sailpoint.APIClient, name string, diags *diag.Diagnostics) bool {
+	if client.V2025.GetConfig().Experimental {
+		return true
+	}
+
+	diags.AddError(
+		"Experimental API Not Enabled",
+		fmt.Sprintf("%s is backed by an experimental SailPoint API, which the provider configuration %s doesn't enable. Set experimental = true in that provider block (or SAIL_EXPERIMENTAL=true when it doesn't set experimental) to use it.", name, p.label),
+	)
+	return false
+}
+
+// requireExperimental is the plan time counterpart of experimentalEnabled,
+// for the ValidateConfig methods of the data sources and resources backed by
+// an experimental API. Read could otherwise be deferred to the apply, when
+// its configuration depends on values unknown at plan time. p is nil when the
+// provider isn't configured yet, as with terraform validate, the check is
+// then left to experimentalEnabled.
+func (p *providerData) requireExperimental(name string, diags *diag.Diagnostics) {
+	if p == nil {
+		return
+	}
+	p.experimentalEnabled(p.client(), name, diags)
+}
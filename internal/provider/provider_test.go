@@ -4,11 +4,17 @@
 package provider
 
 import (
+	"context"
+	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -32,3 +38,138 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestConfigOrEnv(t *testing.T) {
+	t.Setenv("SAIL_TEST_VALUE", "from-env")
+
+	cases := map[string]struct {
+		value    types.String
+		expected string
+	}{
+		"null":    {value: types.StringNull(), expected: "from-env"},
+		"unknown": {value: types.StringUnknown(), expected: "from-env"},
+		"empty":   {value: types.StringValue(""), expected: "from-env"},
+		"set":     {value: types.StringValue("from-config"), expected: "from-config"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := configOrEnv(tc.value, "SAIL_TEST_VALUE"); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestProviderLabel(t *testing.T) {
+	if label := providerLabel(types.StringValue("sandbox"), "https://acme.api.identitynow.com"); label != `"sandbox"` {
+		t.Errorf("unexpected label %s", label)
+	}
+	if label := providerLabel(types.StringNull(), "https://acme.api.identitynow.com"); label != "for https://acme.api.identitynow.com" {
+		t.Errorf("unexpected label %s", label)
+	}
+}
+
+func TestProviderDataClientFor(t *testing.T) {
+	data := &providerData{clients: map[string]*sailpoint.APIClient{}, apiVersion: apiVersionV3}
+	for _, version := range apiVersions {
+		data.clients[version] = &sailpoint.APIClient{}
+	}
+
+	cases := map[string]struct {
+		version  types.String
+		versions []string
+		expected *sailpoint.APIClient
+	}{
+		"provider version":             {version: types.StringNull(), versions: apiVersions, expected: data.clients[apiVersionV3]},
+		"provider version unsupported": {version: types.StringNull(), versions: apiVersionsSinceV2024},
+		"override":                     {version: types.StringValue(apiVersionV2024), versions: apiVersionsSinceV2024, expected: data.clients[apiVersionV2024]},
+		"override unsupported":         {version: types.StringValue(apiVersionV3), versions: apiVersionsSinceV2024},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			client := data.clientFor(tc.version, tc.versions, &diags)
+			if client != tc.expected {
+				t.Errorf("unexpected client %p, expected %p", client, tc.expected)
+			}
+			if diags.HasError() != (tc.expected == nil) {
+				t.Errorf("unexpected diagnostics %v", diags)
+			}
+		})
+	}
+}
+
+func TestInt64ConfigOrEnv(t *testing.T) {
+	t.Setenv("SAIL_TEST_INT", "7")
+	t.Setenv("SAIL_TEST_INVALID", "seven")
+
+	if value, ok, err := int64ConfigOrEnv(types.Int64Value(3), "SAIL_TEST_INT"); value != 3 || !ok || err != nil {
+		t.Errorf("configuration: got %d, %t, %v", value, ok, err)
+	}
+	if value, ok, err := int64ConfigOrEnv(types.Int64Null(), "SAIL_TEST_INT"); value != 7 || !ok || err != nil {
+		t.Errorf("environment: got %d, %t, %v", value, ok, err)
+	}
+	if _, ok, _ := int64ConfigOrEnv(types.Int64Null(), "SAIL_TEST_UNSET"); ok {
+		t.Error("unset: expected ok to be false")
+	}
+	if _, ok, err := int64ConfigOrEnv(types.Int64Null(), "SAIL_TEST_INVALID"); !ok || err == nil {
+		t.Error("invalid: expected an error")
+	}
+}
+
+func TestBoolConfigOrEnv(t *testing.T) {
+	t.Setenv("SAIL_TEST_BOOL", "1")
+	t.Setenv("SAIL_TEST_INVALID", "yes")
+
+	if value, err := boolConfigOrEnv(types.BoolValue(false), "SAIL_TEST_BOOL"); value || err != nil {
+		t.Errorf("configuration: got %t, %v", value, err)
+	}
+	if value, err := boolConfigOrEnv(types.BoolNull(), "SAIL_TEST_BOOL"); !value || err != nil {
+		t.Errorf("environment: got %t, %v", value, err)
+	}
+	if value, err := boolConfigOrEnv(types.BoolNull(), "SAIL_TEST_UNSET"); value || err != nil {
+		t.Errorf("unset: got %t, %v", value, err)
+	}
+	if _, err := boolConfigOrEnv(types.BoolNull(), "SAIL_TEST_INVALID"); err == nil {
+		t.Error("invalid: expected an error")
+	}
+}
+
+func TestSplitEnvList(t *testing.T) {
+	got := splitEnvList("sp:scopes:all, idn:sources:read  502,")
+	expected := []string{"sp:scopes:all", "idn:sources:read", "502"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+// TestImportableResourcesAreListable checks that terraform query can list and
+// generate the configuration of every resource which can be imported.
+func TestImportableResourcesAreListable(t *testing.T) {
+	ctx := context.Background()
+	p := &sailpointProvider{}
+
+	listable := map[string]bool{}
+	for _, newListResource := range p.ListResources(ctx) {
+		var resp resource.MetadataResponse
+		newListResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "sailpoint"}, &resp)
+		listable[resp.TypeName] = true
+	}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		if _, ok := r.(resource.ResourceWithImportState); !ok {
+			continue
+		}
+		var resp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "sailpoint"}, &resp)
+		if _, ok := r.(resource.ResourceWithIdentity); !ok {
+			t.Errorf("%s can be imported but has no identity", resp.TypeName)
+		}
+		if !listable[resp.TypeName] {
+			t.Errorf("%s can be imported but has no list resource", resp.TypeName)
+		}
+	}
+}
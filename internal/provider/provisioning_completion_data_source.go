@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const provisioningCompletionDefaultTimeout = 10 * time.Minute
+
+var (
+	_ datasource.DataSource                   = &provisioningCompletionDataSource{}
+	_ datasource.DataSourceWithConfigure      = &provisioningCompletionDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &provisioningCompletionDataSource{}
+)
+
+func NewProvisioningCompletionDataSource() datasource.DataSource {
+	return &provisioningCompletionDataSource{}
+}
+
+type provisioningCompletionDataSource struct {
+	data *providerData
+}
+
+type provisioningCompletionDataSourceModel struct {
+	APIVersion        types.String                      `tfsdk:"api_version"`
+	AccessRequestID   types.String                      `tfsdk:"access_request_id"`
+	AccountActivityID types.String                      `tfsdk:"account_activity_id"`
+	Timeout           types.String                      `tfsdk:"timeout"`
+	Status            types.String                      `tfsdk:"status"`
+	Items             []provisioningCompletionItemModel `tfsdk:"items"`
+}
+
+type provisioningCompletionItemModel struct {
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	State             types.String `tfsdk:"state"`
+	AccountActivityID types.String `tfsdk:"account_activity_id"`
+}
+
+func (d *provisioningCompletionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provisioning_completion"
+}
+
+func (d *provisioningCompletionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits until the provisioning of an access request or an account activity completes, to verify provisioning end to end, as in CI tenants. Reading fails when the provisioning fails or doesn't complete within the timeout.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"access_request_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the access request, whose requested items must all be provisioned. Exactly one of access_request_id or account_activity_id must be set.",
+			},
+			"account_activity_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the account activity, as returned by the account APIs, which must complete successfully.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{durationValidator{}},
+				Description: "How long to wait for the provisioning to complete, as a Go duration string (defaults to 10m).",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Final status of the provisioning: REQUEST_COMPLETED for access requests, SUCCESS for account activities.",
+			},
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Requested items of the access request. Empty for account activities.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the requested access.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the requested access: ACCESS_PROFILE, ROLE or ENTITLEMENT.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "State of the request of the item.",
+						},
+						"account_activity_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the account activity provisioning the item.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *provisioningCompletionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ProvisioningCompletion data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *provisioningCompletionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config provisioningCompletionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.AccessRequestID.IsNull() == config.AccountActivityID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Provisioning Completion Target",
+			"Exactly one of access_request_id or account_activity_id must be set.",
+		)
+	}
+}
+
+func (d *provisioningCompletionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Provisioning Completion")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state provisioningCompletionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := timeoutValue(state.Timeout, provisioningCompletionDefaultTimeout)
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var task, status string
+	var items []api_v2025.RequestedItemStatus
+	var res *http.Response
+	var err error
+	if activityID := state.AccountActivityID.ValueString(); activityID != "" {
+		task = "Account activity " + activityID
+		status, res, err = waitAccountActivity(pollCtx, client, task, activityID)
+	} else {
+		requestID := state.AccessRequestID.ValueString()
+		task = "Access request " + requestID
+		status, items, res, err = waitAccessRequest(pollCtx, client, task, requestID)
+	}
+	if err != nil {
+		addTaskPollError(ctx, &resp.Diagnostics, "Provisioning", task, timeout, status, err, res)
+		return
+	}
+
+	state.Status = types.StringValue(status)
+	state.Items = make([]provisioningCompletionItemModel, 0, len(items))
+	for _, item := range items {
+		state.Items = append(state.Items, provisioningCompletionItemModel{
+			Name:              types.StringPointerValue(item.Name.Get()),
+			Type:              types.StringPointerValue(item.Type.Get()),
+			State:             types.StringValue(string(item.GetState())),
+			AccountActivityID: types.StringPointerValue(item.AccountActivityItemId),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// waitAccessRequest polls the statuses of the requested items of an access
+// request until they are all provisioned, and returns the last ones. Requests
+// are listed once indexed, so a request without items is pending, and it
+// fails as soon as one of its items is rejected, cancelled or fails. task
+// describes the request as in pollTask.
+func waitAccessRequest(ctx context.Context, client *sailpoint.APIClient, task string, requestID string) (string, []api_v2025.RequestedItemStatus, *http.Response, error) {
+	var items []api_v2025.RequestedItemStatus
+	status, res, err := pollTask(ctx, task, func(ctx context.Context) (taskPoll, *http.Response, error) {
+		var res *http.Response
+		var err error
+		items, res, err = client.V2025.AccessRequestsAPI.ListAccessRequestStatus(ctx).Filters(fmt.Sprintf("accessRequestId eq %q", requestID)).Execute()
+		if err != nil {
+			return taskPoll{}, res, err
+		}
+		if len(items) == 0 {
+			return taskPoll{Status: taskStatusPending}, res, nil
+		}
+
+		for _, item := range items {
+			switch state := item.GetState(); state {
+			case api_v2025.REQUESTEDITEMSTATUSREQUESTSTATE_REQUEST_COMPLETED:
+			case api_v2025.REQUESTEDITEMSTATUSREQUESTSTATE_EXECUTING, api_v2025.REQUESTEDITEMSTATUSREQUESTSTATE_PROVISIONING_VERIFICATION_PENDING, "":
+				return taskPoll{Status: string(api_v2025.REQUESTEDITEMSTATUSREQUESTSTATE_EXECUTING)}, res, nil
+			default:
+				return taskPoll{}, res, &taskFailedError{Task: task, Status: requestedItemFailure(item)}
+			}
+		}
+		return taskPoll{Status: string(api_v2025.REQUESTEDITEMSTATUSREQUESTSTATE_REQUEST_COMPLETED), Done: true}, res, nil
+	})
+	return status, items, res, err
+}
+
+// requestedItemFailure describes the state of a requested item which wasn't
+// provisioned, with its error messages.
+func requestedItemFailure(item api_v2025.RequestedItemStatus) string {
+	status := fmt.Sprintf("%s for %q", item.GetState(), item.GetName())
+	var messages []string
+	for _, localized := range item.ErrorMessages {
+		// Messages are localized, the first locale is enough.
+		if len(localized) > 0 && localized[0].GetText() != "" {
+			messages = append(messages, localized[0].GetText())
+		}
+	}
+	if len(messages) > 0 {
+		status += ": " + strings.Join(messages, "; ")
+	}
+	return status
+}
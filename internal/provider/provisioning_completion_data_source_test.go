@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestWaitAccessRequest(t *testing.T) {
+	fake := newFakeSailPoint(t)
+	fake.respond(http.MethodGet, "/v2025/access-request-status", http.StatusOK, `[
+		{"name": "Engineering", "type": "ROLE", "state": "REQUEST_COMPLETED", "accountActivityItemId": "a1"},
+		{"name": "Admins", "type": "ENTITLEMENT", "state": "PROVISIONING_FAILED", "errorMessages": [[{"locale": "en-US", "text": "Account locked"}]]}
+	]`)
+	client := fake.providerData().client()
+
+	_, items, _, err := waitAccessRequest(context.Background(), client, "Access request r1", "r1")
+	var failed *taskFailedError
+	if !errors.As(err, &failed) || failed.Status != `PROVISIONING_FAILED for "Admins": Account locked` {
+		t.Errorf("expected the request to fail, got %v", err)
+	}
+	if len(items) != 2 {
+		t.Errorf("expected the last items, got %+v", items)
+	}
+	if requested := fake.requested(); len(requested) != 1 {
+		t.Errorf("expected 1 request, got %v", requested)
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultRateLimitWarningThreshold is the share of the tenant rate limit, in
+// percent, past which the run warns about its rate limit usage.
+const defaultRateLimitWarningThreshold = 80
+
+// rateLimitUsage tracks how close the requests of a provider instance came to
+// the tenant rate limit, from the X-RateLimit-* headers of the responses.
+type rateLimitUsage struct {
+	// warningThreshold is the usage, in percent, past which warnRateLimit
+	// warns. Zero disables the warning.
+	warningThreshold int
+
+	mu sync.Mutex
+	// limit is the rate limit reported by the response with the peak usage.
+	limit int
+	// peak is the highest usage seen, in percent of limit.
+	peak int
+	// rateLimited counts the responses rejected with 429 Too Many Requests.
+	rateLimited int
+	warned      bool
+}
+
+func newRateLimitUsage(warningThreshold int) *rateLimitUsage {
+	return &rateLimitUsage{warningThreshold: warningThreshold}
+}
+
+// record updates the usage from the headers of a response.
+func (u *rateLimitUsage) record(resp *http.Response) {
+	limit, limitErr := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	remaining, remainingErr := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		u.rateLimited++
+	}
+	if limitErr != nil || remainingErr != nil || limit <= 0 {
+		return
+	}
+
+	if usage := (limit - remaining) * 100 / limit; usage > u.peak {
+		u.peak = usage
+		u.limit = limit
+	}
+}
+
+// warnRateLimit adds a warning diagnostic the first time the usage goes past
+// the warning threshold, or requests are rate limited. It is called by every
+// data source and resource operation, so the warning shows up on the
+// operation which crossed the threshold.
+func (u *rateLimitUsage) warnRateLimit(diags *diag.Diagnostics) {
+	if u == nil || u.warningThreshold == 0 {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.warned || (u.peak < u.warningThreshold && u.rateLimited == 0) {
+		return
+	}
+	u.warned = true
+
+	detail := fmt.Sprintf("The requests of this run used up to %d%% of the tenant rate limit of %d requests", u.peak, u.limit)
+	if u.limit == 0 {
+		detail = "The tenant didn't report its rate limit"
+	}
+	if u.rateLimited > 0 {
+		detail += fmt.Sprintf(", and %d of them were rejected with 429 Too Many Requests", u.rateLimited)
+	}
+	diags.AddWarning(
+		"SailPoint API Rate Limit Nearly Reached",
+		detail+". Other clients of the tenant may be rate limited as well. Lower max_concurrent_requests or Terraform's -parallelism to spread the requests out, or raise rate_limit.warning_threshold to silence this warning.",
+	)
+}
+
+// rateLimitTransport records the rate limit headers of every response, and
+// logs them at debug level.
+type rateLimitTransport struct {
+	base  http.RoundTripper
+	usage *rateLimitUsage
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	t.usage.record(resp)
+	if limit := resp.Header.Get("X-RateLimit-Limit"); limit != "" {
+		tflog.Debug(req.Context(), "SailPoint API rate limit", map[string]any{
+			"url":       req.URL.String(),
+			"status":    resp.StatusCode,
+			"limit":     limit,
+			"remaining": resp.Header.Get("X-RateLimit-Remaining"),
+			"reset":     resp.Header.Get("X-RateLimit-Reset"),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestRateLimitUsageWarning(t *testing.T) {
+	response := func(status, remaining int) *http.Response {
+		header := http.Header{}
+		header.Set("X-RateLimit-Limit", "100")
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		return &http.Response{StatusCode: status, Header: header}
+	}
+
+	usage := newRateLimitUsage(80)
+	usage.record(response(http.StatusOK, 40))
+
+	var diags diag.Diagnostics
+	usage.warnRateLimit(&diags)
+	if diags.WarningsCount() != 0 {
+		t.Fatalf("unexpected warning below the threshold: %v", diags)
+	}
+
+	usage.record(response(http.StatusOK, 10))
+	usage.record(response(http.StatusOK, 50))
+	usage.warnRateLimit(&diags)
+	usage.warnRateLimit(&diags)
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if usage.peak != 90 {
+		t.Errorf("expected a peak usage of 90%%, got %d%%", usage.peak)
+	}
+
+	disabled := newRateLimitUsage(0)
+	disabled.record(response(http.StatusTooManyRequests, 0))
+	diags = nil
+	disabled.warnRateLimit(&diags)
+	if diags.WarningsCount() != 0 {
+		t.Errorf("unexpected warning with the threshold disabled: %v", diags)
+	}
+}
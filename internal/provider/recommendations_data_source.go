@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+// recommendationItemTypePattern matches the types of the access items IAI
+// recommends.
+var recommendationItemTypePattern = regexp.MustCompile(`^(ENTITLEMENT|ACCESS_PROFILE|ROLE)$`)
+
+var (
+	_ datasource.DataSource                   = &recommendationsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &recommendationsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &recommendationsDataSource{}
+)
+
+func NewRecommendationsDataSource() datasource.DataSource {
+	return &recommendationsDataSource{}
+}
+
+type recommendationsDataSource struct {
+	data *providerData
+}
+
+type recommendationsDataSourceModel struct {
+	APIVersion      types.String                 `tfsdk:"api_version"`
+	Requests        []recommendationRequestModel `tfsdk:"requests"`
+	Recommendations []recommendationModel        `tfsdk:"recommendations"`
+}
+
+type recommendationRequestModel struct {
+	IdentityID types.String `tfsdk:"identity_id"`
+	ItemID     types.String `tfsdk:"item_id"`
+	ItemType   types.String `tfsdk:"item_type"`
+}
+
+type recommendationModel struct {
+	IdentityID           types.String  `tfsdk:"identity_id"`
+	ItemID               types.String  `tfsdk:"item_id"`
+	ItemType             types.String  `tfsdk:"item_type"`
+	Recommendation       types.String  `tfsdk:"recommendation"`
+	Interpretations      types.List    `tfsdk:"interpretations"`
+	OverallWeightedScore types.Float32 `tfsdk:"overall_weighted_score"`
+	Threshold            types.Float32 `tfsdk:"threshold"`
+}
+
+func (d *recommendationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recommendations"
+}
+
+func (d *recommendationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Asks IAI whether identities should keep or be granted access items, as in certifications and access requests, with the reasons and score of each recommendation, for decision support tooling.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"requests": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Pairs of identities and access items to recommend.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identity_id": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{sailPointIDValidator{}},
+							Description: "ID of the identity.",
+						},
+						"item_id": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{sailPointIDValidator{}},
+							Description: "ID of the access item.",
+						},
+						"item_type": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringPatternValidator{pattern: recommendationItemTypePattern, message: "must be ENTITLEMENT, ACCESS_PROFILE or ROLE"}},
+							Description: "Type of the access item: ENTITLEMENT, ACCESS_PROFILE or ROLE.",
+						},
+					},
+				},
+			},
+			"recommendations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Recommendations, in the order of the requests.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identity_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the identity.",
+						},
+						"item_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the access item.",
+						},
+						"item_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the access item.",
+						},
+						"recommendation": schema.StringAttribute{
+							Computed:    true,
+							Description: "YES when the access is recommended (approve), NO when it isn't (deny), MAYBE without enough information, NOT_FOUND when the identity is unknown to IAI.",
+						},
+						"interpretations": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Reasons of the recommendation (ex. Not approved in the last 6 months.).",
+						},
+						"overall_weighted_score": schema.Float32Attribute{
+							Computed:    true,
+							Description: "Weighted score of the access for the identity, which the recommendation compares to threshold.",
+						},
+						"threshold": schema.Float32Attribute{
+							Computed:    true,
+							Description: "Score from which the access is recommended.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *recommendationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Recommendations data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// ValidateConfig rejects the data source at plan time when the provider
+// doesn't enable experimental APIs.
+func (d *recommendationsDataSource) ValidateConfig(_ context.Context, _ datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	d.data.requireExperimental("sailpoint_recommendations", &resp.Diagnostics)
+}
+
+func (d *recommendationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Recommendations")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state recommendationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !d.data.experimentalEnabled(client, "sailpoint_recommendations", &resp.Diagnostics) {
+		return
+	}
+
+	// The scores are only returned with the debug information.
+	request := api_v2025.RecommendationRequestDto{
+		Requests:                make([]api_v2025.RecommendationRequest, 0, len(state.Requests)),
+		ExcludeInterpretations:  api_v2025.PtrBool(false),
+		IncludeDebugInformation: api_v2025.PtrBool(true),
+	}
+	for _, r := range state.Requests {
+		request.Requests = append(request.Requests, api_v2025.RecommendationRequest{
+			IdentityId: r.IdentityID.ValueStringPointer(),
+			Item: &api_v2025.AccessItemRef{
+				Id:   r.ItemID.ValueStringPointer(),
+				Type: r.ItemType.ValueStringPointer(),
+			},
+		})
+	}
+
+	result, res, err := client.V2025.IAIRecommendationsAPI.GetRecommendations(ctx).RecommendationRequestDto(request).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Recommendations", err, res)
+		return
+	}
+
+	state.Recommendations = make([]recommendationModel, 0, len(result.Response))
+	for _, recommendation := range result.Response {
+		request := recommendation.GetRequest()
+		item := request.GetItem()
+		calculations := recommendation.GetRecommenderCalculations()
+		interpretations, diags := types.ListValueFrom(ctx, types.StringType, recommendation.Interpretations)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Recommendations = append(state.Recommendations, recommendationModel{
+			IdentityID:           types.StringPointerValue(request.IdentityId),
+			ItemID:               types.StringPointerValue(item.Id),
+			ItemType:             types.StringPointerValue(item.Type),
+			Recommendation:       types.StringPointerValue(recommendation.Recommendation),
+			Interpretations:      interpretations,
+			OverallWeightedScore: types.Float32PointerValue(calculations.OverallWeightedScore),
+			Threshold:            types.Float32PointerValue(calculations.Threshold),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
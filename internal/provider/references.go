@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+)
+
+// referenceKind is a type of object attributes reference by ID, and how
+// validate_references reads one to check it exists.
+type referenceKind struct {
+	name string
+	get  func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error)
+}
+
+var (
+	accessProfileReference = referenceKind{"access profile", func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error) {
+		_, res, err := client.V2025.AccessProfilesAPI.GetAccessProfile(ctx, id).Execute()
+		return res, err
+	}}
+	campaignReference = referenceKind{"certification campaign", func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error) {
+		_, res, err := client.V2025.CertificationCampaignsAPI.GetCampaign(ctx, id).Execute()
+		return res, err
+	}}
+	entitlementReference = referenceKind{"entitlement", func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error) {
+		_, res, err := client.V2025.EntitlementsAPI.GetEntitlement(ctx, id).Execute()
+		return res, err
+	}}
+	identityReference = referenceKind{"identity", func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error) {
+		_, res, err := client.V2025.IdentitiesAPI.GetIdentity(ctx, id).Execute()
+		return res, err
+	}}
+	identityProfileReference = referenceKind{"identity profile", func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error) {
+		_, res, err := client.V2025.IdentityProfilesAPI.GetIdentityProfile(ctx, id).Execute()
+		return res, err
+	}}
+	roleReference = referenceKind{"role", func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error) {
+		_, res, err := client.V2025.RolesAPI.GetRole(ctx, id).Execute()
+		return res, err
+	}}
+	sodPolicyReference = referenceKind{"SOD policy", func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error) {
+		_, res, err := client.V2025.SODPoliciesAPI.GetSodPolicy(ctx, id).Execute()
+		return res, err
+	}}
+	sourceReference = referenceKind{"source", func(ctx context.Context, client *sailpoint.APIClient, id string) (*http.Response, error) {
+		_, res, err := client.V2025.SourcesAPI.GetSource(ctx, id).Execute()
+		return res, err
+	}}
+)
+
+// reference is an attribute referencing an object by ID.
+type reference struct {
+	path path.Path
+	kind referenceKind
+	id   types.String
+}
+
+// attributeGetter is a plan or a state.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// attributeReferences returns the references of the string, list or set of
+// strings attribute name, none when it is null or unknown.
+func attributeReferences(ctx context.Context, data attributeGetter, name string, kind referenceKind, diags *diag.Diagnostics) []reference {
+	var value attr.Value
+	diags.Append(data.GetAttribute(ctx, path.Root(name), &value)...)
+
+	var references []reference
+	switch value := value.(type) {
+	case types.String:
+		references = append(references, reference{path: path.Root(name), kind: kind, id: value})
+	case types.List:
+		for i, element := range value.Elements() {
+			if id, ok := element.(types.String); ok {
+				references = append(references, reference{path: path.Root(name).AtListIndex(i), kind: kind, id: id})
+			}
+		}
+	case types.Set:
+		for _, element := range value.Elements() {
+			if id, ok := element.(types.String); ok {
+				references = append(references, reference{path: path.Root(name).AtSetValue(id), kind: kind, id: id})
+			}
+		}
+	}
+	return references
+}
+
+// addedReferences returns the references of planned to known IDs which
+// prior doesn't reference, those a plan adds or changes.
+func addedReferences(planned, prior []reference) []reference {
+	inPrior := make(map[string]bool, len(prior))
+	for _, reference := range prior {
+		inPrior[reference.key()] = true
+	}
+
+	var added []reference
+	for _, reference := range planned {
+		if reference.id.IsNull() || reference.id.IsUnknown() || inPrior[reference.key()] {
+			continue
+		}
+		added = append(added, reference)
+	}
+	return added
+}
+
+// key identifies the referenced object, whatever the attribute referencing
+// it.
+func (r reference) key() string {
+	return r.kind.name + "/" + r.id.ValueString()
+}
+
+// planReferences returns the references a plan adds or changes in the
+// attributes of kinds, which map attribute names to the kind of objects they
+// reference.
+func planReferences(ctx context.Context, req resource.ModifyPlanRequest, kinds map[string]referenceKind, diags *diag.Diagnostics) []reference {
+	var planned, prior []reference
+	for _, name := range slices.Sorted(maps.Keys(kinds)) {
+		planned = append(planned, attributeReferences(ctx, req.Plan, name, kinds[name], diags)...)
+		if !req.State.Raw.IsNull() {
+			prior = append(prior, attributeReferences(ctx, req.State, name, kinds[name], diags)...)
+		}
+	}
+	return addedReferences(planned, prior)
+}
+
+// checkReferences reports the referenced objects which don't exist in the
+// tenant, for the ModifyPlan methods of the resources when the provider
+// enables validate_references, so a missing object fails the plan instead of
+// the apply midway. The objects are read with a request each. References to
+// objects created in the same apply are unknown at plan time and aren't
+// checked.
+func checkReferences(ctx context.Context, client *sailpoint.APIClient, references []reference, diags *diag.Diagnostics) {
+	if len(references) == 0 {
+		return
+	}
+
+	responses := make([]*http.Response, len(references))
+	errs := make([]error, len(references))
+	_, _ = forEachConcurrently(ctx, len(references), detailFetchWorkers, func(ctx context.Context, i int) error {
+		responses[i], errs[i] = references[i].kind.get(ctx, client, references[i].id.ValueString())
+		return nil
+	})
+
+	for i, reference := range references {
+		res, err := responses[i], errs[i]
+		switch {
+		// An object the SDK can't decode exists all the same.
+		case res != nil && res.StatusCode < http.StatusMultipleChoices:
+		case res != nil && res.StatusCode == http.StatusNotFound:
+			diags.AddAttributeError(
+				reference.path,
+				"Referenced Object Not Found",
+				fmt.Sprintf("No %s has the ID %s in the tenant.", reference.kind.name, reference.id.ValueString()),
+			)
+		case err != nil:
+			addAPIError(ctx, diags, fmt.Sprintf("Unable to Check %s %s Exists", reference.kind.name, reference.id.ValueString()), err, res)
+		}
+	}
+}
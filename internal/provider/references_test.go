@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	testRoleID        = "2c9180857182305e0171993735622948"
+	testIdentityID    = "2c9180867624cbd7017642d8c8c81f67"
+	testNewIdentityID = "2c918084660f45d6016617daa9210584"
+)
+
+func TestRoleMembershipModifyPlanValidateReferences(t *testing.T) {
+	ctx := context.Background()
+	r := NewRoleMembershipResource()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	identityIDsType := objectType.AttributeTypes["identity_ids"]
+	membership := func(identityIDs tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":           tftypes.NewValue(tftypes.String, testRoleID),
+			"role_id":      tftypes.NewValue(tftypes.String, testRoleID),
+			"identity_ids": identityIDs,
+		})
+	}
+	identityIDs := func(ids ...string) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, tftypes.NewValue(tftypes.String, id))
+		}
+		return tftypes.NewValue(identityIDsType, values)
+	}
+
+	for name, test := range map[string]struct {
+		state     tftypes.Value
+		plan      tftypes.Value
+		requested []string
+		invalid   []path.Path
+	}{
+		"create": {
+			state:     tftypes.NewValue(objectType, nil),
+			plan:      membership(tftypes.NewValue(identityIDsType, tftypes.UnknownValue)),
+			requested: []string{"GET /v2025/roles/" + testRoleID},
+		},
+		"added identity": {
+			state:     membership(identityIDs(testIdentityID)),
+			plan:      membership(identityIDs(testIdentityID, testNewIdentityID)),
+			requested: []string{"GET /v2025/identities/" + testNewIdentityID},
+			invalid:   []path.Path{path.Root("identity_ids").AtSetValue(types.StringValue(testNewIdentityID))},
+		},
+		"unchanged": {
+			state: membership(identityIDs(testIdentityID)),
+			plan:  membership(identityIDs(testIdentityID)),
+		},
+		"destroy": {
+			state: membership(identityIDs(testIdentityID)),
+			plan:  tftypes.NewValue(objectType, nil),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			fake := newFakeSailPoint(t)
+			fake.respond(http.MethodGet, "/v2025/roles/"+testRoleID, http.StatusOK, `{"id": "`+testRoleID+`", "name": "Break Glass"}`)
+			fake.respond(http.MethodGet, "/v2025/identities/"+testNewIdentityID, http.StatusNotFound, `{"detailCode": "404 Not found"}`)
+			data := fake.providerData()
+			data.validateReferences = true
+
+			var configureResp resource.ConfigureResponse
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: data}, &configureResp)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: test.state},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: test.plan},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, &resp)
+
+			if requested := fake.requested(); !slices.Equal(requested, test.requested) {
+				t.Errorf("expected requests %v, got %v", test.requested, requested)
+			}
+			var invalid []path.Path
+			for _, d := range resp.Diagnostics.Errors() {
+				withPath, ok := d.(interface{ Path() path.Path })
+				if !ok || d.Summary() != "Referenced Object Not Found" {
+					t.Fatalf("unexpected error %s: %s", d.Summary(), d.Detail())
+				}
+				invalid = append(invalid, withPath.Path())
+			}
+			if !slices.EqualFunc(invalid, test.invalid, path.Path.Equal) {
+				t.Errorf("expected errors at %v, got %v", test.invalid, invalid)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	reportResultDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the report task result.",
+		},
+		"report_type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the report, such as ACCOUNTS or IDENTITIES.",
+		},
+		"task_def_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the task definition which ran the report.",
+		},
+		"status": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the report, such as SUCCESS, FAILURE, WARNING or PENDING.",
+		},
+		"duration": dataSchema.Int64Attribute{
+			Computed:    true,
+			Description: "Report processing time in milliseconds",
+		},
+		"rows": dataSchema.Int64Attribute{
+			Computed:    true,
+			Description: "Report size in rows",
+		},
+		"available_formats": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Output file formats the report can be downloaded in",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Date the report was run.",
+		},
+	}
+)
+
+type reportResultModel struct {
+	ID               types.String `tfsdk:"id"`
+	ReportType       types.String `tfsdk:"report_type"`
+	TaskDefName      types.String `tfsdk:"task_def_name"`
+	Status           types.String `tfsdk:"status"`
+	Duration         types.Int64  `tfsdk:"duration"`
+	Rows             types.Int64  `tfsdk:"rows"`
+	AvailableFormats types.List   `tfsdk:"available_formats"`
+	Created          rfc3339Value `tfsdk:"created"`
+}
+
+type reportResultDataSourceModel struct {
+	APIVersion       types.String `tfsdk:"api_version"`
+	TaskResultID     types.String `tfsdk:"task_result_id"`
+	Completed        types.Bool   `tfsdk:"completed"`
+	ID               types.String `tfsdk:"id"`
+	ReportType       types.String `tfsdk:"report_type"`
+	TaskDefName      types.String `tfsdk:"task_def_name"`
+	Status           types.String `tfsdk:"status"`
+	Duration         types.Int64  `tfsdk:"duration"`
+	Rows             types.Int64  `tfsdk:"rows"`
+	AvailableFormats types.List   `tfsdk:"available_formats"`
+	Created          rfc3339Value `tfsdk:"created"`
+}
+
+type reportsDataSourceModel struct {
+	APIVersion    types.String        `tfsdk:"api_version"`
+	TaskResultIDs types.List          `tfsdk:"task_result_ids"`
+	Completed     types.Bool          `tfsdk:"completed"`
+	Reports       []reportResultModel `tfsdk:"reports"`
+}
+
+func serializeReportResultData(ctx context.Context, report api_v2025.ReportResults) (reportResultModel, diag.Diagnostics) {
+	availableFormats, diags := types.ListValueFrom(ctx, types.StringType, report.AvailableFormats)
+	if diags.HasError() {
+		return reportResultModel{}, diags
+	}
+
+	return reportResultModel{
+		ID:               types.StringPointerValue(report.Id),
+		ReportType:       types.StringPointerValue(report.ReportType),
+		TaskDefName:      types.StringPointerValue(report.TaskDefName),
+		Status:           types.StringPointerValue(report.Status),
+		Duration:         types.Int64PointerValue(report.Duration),
+		Rows:             types.Int64PointerValue(report.Rows),
+		AvailableFormats: availableFormats,
+		Created:          sailPointTimeValue(report.Created),
+	}, nil
+}
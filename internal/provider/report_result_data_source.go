@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &reportResultDataSource{}
+	_ datasource.DataSourceWithConfigure = &reportResultDataSource{}
+)
+
+func NewReportResultDataSource() datasource.DataSource {
+	return &reportResultDataSource{}
+}
+
+type reportResultDataSource struct {
+	data *providerData
+}
+
+func (d *reportResultDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_report_result"
+}
+
+func (d *reportResultDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+		"task_result_id": schema.StringAttribute{
+			Required:    true,
+			Validators:  []validator.String{sailPointIDValidator{}},
+			Description: "ID of the task result which handled the report",
+		},
+		"completed": schema.BoolAttribute{
+			Optional:    true,
+			Description: "State of the task result used to order the results when they are fetched",
+		},
+	}
+	maps.Copy(attributes, reportResultDataSourceSchemaAttributes)
+
+	resp.Schema = schema.Schema{
+		Description: "Reads the result of a report task.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *reportResultDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ReportResult data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *reportResultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Report Result")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state reportResultDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskResultID := state.TaskResultID.ValueString()
+	if taskResultID == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Read Report Result",
+			"task_result_id cannot be empty",
+		)
+		return
+	}
+
+	request := client.V2025.ReportsDataExtractionAPI.GetReportResult(ctx, taskResultID)
+	if !state.Completed.IsNull() {
+		request = request.Completed(state.Completed.ValueBool())
+	}
+
+	report, res, err := request.Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Report Result", err, res)
+		return
+	}
+
+	result, diags := serializeReportResultData(ctx, *report)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = result.ID
+	state.ReportType = result.ReportType
+	state.TaskDefName = result.TaskDefName
+	state.Status = result.Status
+	state.Duration = result.Duration
+	state.Rows = result.Rows
+	state.AvailableFormats = result.AvailableFormats
+	state.Created = result.Created
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
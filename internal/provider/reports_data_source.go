@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &reportsDataSource{}
+	_ datasource.DataSourceWithConfigure = &reportsDataSource{}
+)
+
+func NewReportsDataSource() datasource.DataSource {
+	return &reportsDataSource{}
+}
+
+type reportsDataSource struct {
+	data *providerData
+}
+
+func (d *reportsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reports"
+}
+
+func (d *reportsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the report results of the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"task_result_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{sailPointIDValidator{}},
+				Description: "IDs of the task results which handled the reports (ex. the IDs returned when the reports were scheduled)",
+			},
+			"completed": schema.BoolAttribute{
+				Optional:    true,
+				Description: "State of the task results used to order the results when they are fetched",
+			},
+			"reports": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Report results of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: reportResultDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *reportsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint Reports data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *reportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Reports")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state reportsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskResultIDs := make([]string, 0)
+	resp.Diagnostics.Append(state.TaskResultIDs.ElementsAs(ctx, &taskResultIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reports := make([]*v2025.ReportResults, len(taskResultIDs))
+	responses := make([]*http.Response, len(taskResultIDs))
+	failed, err := forEachConcurrently(ctx, len(taskResultIDs), detailFetchWorkers, func(ctx context.Context, i int) error {
+		tflog.Debug(ctx, "Reading report result", map[string]any{"task_result_id": taskResultIDs[i]})
+
+		request := client.V2025.ReportsDataExtractionAPI.GetReportResult(ctx, taskResultIDs[i])
+		if !state.Completed.IsNull() {
+			request = request.Completed(state.Completed.ValueBool())
+		}
+
+		var err error
+		reports[i], responses[i], err = request.Execute()
+		return err
+	})
+
+	if err != nil {
+		var res *http.Response
+		taskResultID := ""
+		if failed >= 0 {
+			res, taskResultID = responses[failed], taskResultIDs[failed]
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Reports",
+			fmt.Sprintf("Reading report result %s: %s", taskResultID, describeAPIError(ctx, "Unable to Read Reports", err, res)),
+		)
+		return
+	}
+
+	state.Reports = make([]reportResultModel, 0, len(reports))
+	for _, report := range reports {
+		reportState, diags := serializeReportResultData(ctx, *report)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Reports = append(state.Reports, reportState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+)
+
+// responseCache memoizes the reads of data sources for the lifetime of the
+// provider instance, which is a single plan or apply. Data sources of
+// several modules reading the same object then share one API call,
+// concurrent reads of a key waiting for the first one to complete. Failed
+// reads are not cached. Resources never read through the cache since they
+// must see their own changes.
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]*responseCacheEntry
+}
+
+type responseCacheEntry struct {
+	done  chan struct{}
+	value any
+	res   *http.Response
+	err   error
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{entries: map[string]*responseCacheEntry{}}
+}
+
+// cacheKey identifies a read made with client, whose server URL holds the
+// tenant and API version, so reads of different versions are kept apart.
+func cacheKey(client *sailpoint.APIClient, key string) string {
+	servers := client.V2025.GetConfig().Servers
+	if len(servers) == 0 {
+		return key
+	}
+	return servers[0].URL + " " + key
+}
+
+// cachedRead returns the cached result of the read identified by key, calling
+// fetch when there is none. A nil cache always calls fetch.
+func cachedRead[T any](cache *responseCache, key string, fetch func() (T, *http.Response, error)) (T, *http.Response, error) {
+	if cache == nil {
+		return fetch()
+	}
+
+	cache.mu.Lock()
+	entry, ok := cache.entries[key]
+	if !ok {
+		entry = &responseCacheEntry{done: make(chan struct{})}
+		cache.entries[key] = entry
+	}
+	cache.mu.Unlock()
+
+	if !ok {
+		var value T
+		value, entry.res, entry.err = fetch()
+		entry.value = value
+		if entry.err != nil {
+			cache.mu.Lock()
+			delete(cache.entries, key)
+			cache.mu.Unlock()
+		}
+		close(entry.done)
+	} else {
+		<-entry.done
+	}
+
+	value, _ := entry.value.(T)
+	return value, entry.res, entry.err
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCachedRead(t *testing.T) {
+	cache := newResponseCache()
+	var calls atomic.Int32
+	fetch := func() (string, *http.Response, error) {
+		calls.Add(1)
+		return "cluster", nil, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if value, _, err := cachedRead(cache, "managed-clusters/1", fetch); value != "cluster" || err != nil {
+				t.Errorf("unexpected result %q, %v", value, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("expected a single call, got %d", calls.Load())
+	}
+}
+
+func TestCachedReadFailure(t *testing.T) {
+	cache := newResponseCache()
+	calls := 0
+	fetch := func() (string, *http.Response, error) {
+		calls++
+		if calls == 1 {
+			return "", nil, errors.New("unavailable")
+		}
+		return "cluster", nil, nil
+	}
+
+	if _, _, err := cachedRead(cache, "managed-clusters/1", fetch); err == nil {
+		t.Fatal("expected the first read to fail")
+	}
+	if value, _, err := cachedRead(cache, "managed-clusters/1", fetch); value != "cluster" || err != nil {
+		t.Errorf("expected failures not to be cached, got %q, %v", value, err)
+	}
+}
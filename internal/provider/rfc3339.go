@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = rfc3339Type{}
+	_ basetypes.StringValuableWithSemanticEquals = rfc3339Value{}
+)
+
+// rfc3339Type is the custom type of the timestamp attributes. Their values
+// are RFC 3339 strings in state, so they can be parsed and compared in
+// expressions (ex. with timecmp), and two values denoting the same instant
+// in different offsets or precisions are semantically equal.
+type rfc3339Type struct {
+	basetypes.StringType
+}
+
+func (t rfc3339Type) String() string {
+	return "rfc3339Type"
+}
+
+func (t rfc3339Type) Equal(o attr.Type) bool {
+	other, ok := o.(rfc3339Type)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t rfc3339Type) ValueType(_ context.Context) attr.Value {
+	return rfc3339Value{}
+}
+
+func (t rfc3339Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return rfc3339Value{StringValue: in}, nil
+}
+
+func (t rfc3339Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := value.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+
+	return rfc3339Value{StringValue: stringValue}, nil
+}
+
+// rfc3339Value is a timestamp attribute value, see rfc3339Type.
+type rfc3339Value struct {
+	basetypes.StringValue
+}
+
+func (v rfc3339Value) Type(_ context.Context) attr.Type {
+	return rfc3339Type{}
+}
+
+func (v rfc3339Value) Equal(o attr.Value) bool {
+	other, ok := o.(rfc3339Value)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v rfc3339Value) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(rfc3339Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldTime, err := time.Parse(time.RFC3339Nano, v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newTime, err := time.Parse(time.RFC3339Nano, newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return oldTime.Equal(newTime), diags
+}
+
+// ValueTime parses the timestamp, returning the zero time when it is null or
+// unknown.
+func (v rfc3339Value) ValueTime() (time.Time, error) {
+	if v.IsNull() || v.IsUnknown() {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, v.ValueString())
+}
+
+// rfc3339Null returns a null timestamp value.
+func rfc3339Null() rfc3339Value {
+	return rfc3339Value{StringValue: basetypes.NewStringNull()}
+}
+
+// rfc3339TimeValue formats t as a timestamp value, keeping its offset and
+// only the significant digits of its fractional seconds.
+func rfc3339TimeValue(t time.Time) rfc3339Value {
+	return rfc3339Value{StringValue: basetypes.NewStringValue(t.Format(time.RFC3339Nano))}
+}
+
+// rfc3339StringValue converts a timestamp the API returns as a plain string,
+// which is reformatted when it parses as RFC 3339 and kept as is otherwise.
+// An empty string is null.
+func rfc3339StringValue(s *string) rfc3339Value {
+	if s == nil || *s == "" {
+		return rfc3339Null()
+	}
+	if t, err := time.Parse(time.RFC3339Nano, *s); err == nil {
+		return rfc3339TimeValue(t)
+	}
+	return rfc3339Value{StringValue: basetypes.NewStringValue(*s)}
+}
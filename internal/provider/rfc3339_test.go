@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestRFC3339ValueSemanticEquals(t *testing.T) {
+	tests := []struct {
+		old, new string
+		equal    bool
+	}{
+		{"2024-03-01T10:00:00Z", "2024-03-01T10:00:00Z", true},
+		{"2024-03-01T10:00:00Z", "2024-03-01T11:00:00+01:00", true},
+		{"2024-03-01T10:00:00Z", "2024-03-01T10:00:00.000Z", true},
+		{"2024-03-01T10:00:00Z", "2024-03-01T10:00:01Z", false},
+		{"2024-03-01T10:00:00Z", "not a timestamp", false},
+	}
+
+	for _, test := range tests {
+		oldValue := rfc3339Value{StringValue: basetypes.NewStringValue(test.old)}
+		newValue := rfc3339Value{StringValue: basetypes.NewStringValue(test.new)}
+
+		equal, diags := oldValue.StringSemanticEquals(context.Background(), newValue)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if equal != test.equal {
+			t.Errorf("%s and %s: got equal %t", test.old, test.new, equal)
+		}
+	}
+}
+
+func TestRFC3339StringValue(t *testing.T) {
+	empty, raw, timestamp := "", "yesterday", "2024-03-01T10:00:00.120+02:00"
+
+	if value := rfc3339StringValue(nil); !value.IsNull() {
+		t.Errorf("expected null for nil, got %s", value)
+	}
+	if value := rfc3339StringValue(&empty); !value.IsNull() {
+		t.Errorf("expected null for an empty string, got %s", value)
+	}
+	if value := rfc3339StringValue(&raw); value.ValueString() != raw {
+		t.Errorf("expected %q to be kept, got %s", raw, value)
+	}
+
+	value := rfc3339StringValue(&timestamp)
+	if value.ValueString() != "2024-03-01T10:00:00.12+02:00" {
+		t.Errorf("unexpected formatting: %s", value)
+	}
+	parsed, err := value.ValueTime()
+	if err != nil || !parsed.Equal(time.Date(2024, 3, 1, 8, 0, 0, 120e6, time.UTC)) {
+		t.Errorf("unexpected time %s: %v", parsed, err)
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ list.ListResource              = &roleMembershipListResource{}
+	_ list.ListResourceWithConfigure = &roleMembershipListResource{}
+)
+
+func NewRoleMembershipListResource() list.ListResource {
+	return &roleMembershipListResource{}
+}
+
+// roleMembershipListResource lists the memberships of the roles assigned to
+// a list of identities, those the role membership resource can manage.
+type roleMembershipListResource struct {
+	data *providerData
+}
+
+type roleMembershipListResourceModel struct {
+	Filters types.String `tfsdk:"filters"`
+}
+
+func (l *roleMembershipListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_membership"
+}
+
+func (l *roleMembershipListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the memberships of the roles assigned to a list of identities. Roles whose membership is criteria based are skipped.",
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter of the roles listed, using the standard syntax described in V3 API Standard Collection Parameters (ex. name sw \"Break Glass\").",
+			},
+		},
+	}
+}
+
+func (l *roleMembershipListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint RoleMembership list resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.data = data
+}
+
+func (l *roleMembershipListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, "Listing Role Memberships")
+
+	var (
+		config roleMembershipListResourceModel
+		diags  diag.Diagnostics
+	)
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// The membership type can't be filtered, so all the roles are read and
+	// the limit applies to those assigned to a list of identities.
+	pagination := paginationModel{MaxResults: types.Int64Value(0)}
+	roles, res, err := paginate[api_v2025.Role](l.data.client().V2025.RolesAPI.ListRoles(ctx).Filters(config.Filters.ValueString()), &pagination)
+	l.data.rateLimit.warnRateLimit(&diags)
+	if err != nil {
+		addAPIError(ctx, &diags, "Unable to List Roles", err, res)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var listed int64
+		for _, role := range roles {
+			if req.Limit > 0 && listed == req.Limit {
+				return
+			}
+			if membership, _ := role.GetMembershipOk(); membership.GetType() != api_v2025.ROLEMEMBERSHIPSELECTORTYPE_IDENTITY_LIST {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = role.GetName()
+			if listed == 0 {
+				// Rate limit warnings
+				result.Diagnostics.Append(diags...)
+			}
+			setIDIdentity(ctx, result.Identity, types.StringValue(role.GetId()), &result.Diagnostics)
+
+			if req.IncludeResource {
+				state := roleMembershipResourceModel{
+					ID:          types.StringValue(role.GetId()),
+					RoleID:      types.StringValue(role.GetId()),
+					IdentityIDs: roleMembershipIdentityIDs(&role),
+				}
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+
+			listed++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ resource.Resource                 = &roleMembershipResource{}
+	_ resource.ResourceWithConfigure    = &roleMembershipResource{}
+	_ resource.ResourceWithImportState  = &roleMembershipResource{}
+	_ resource.ResourceWithIdentity     = &roleMembershipResource{}
+	_ resource.ResourceWithUpgradeState = &roleMembershipResource{}
+	_ resource.ResourceWithModifyPlan   = &roleMembershipResource{}
+)
+
+var roleMembershipStateUpgrades []stateUpgrade
+
+func NewRoleMembershipResource() resource.Resource {
+	return &roleMembershipResource{}
+}
+
+type roleMembershipResource struct {
+	client                 *sailpoint.APIClient
+	rateLimit              *rateLimitUsage
+	readAfterCreateTimeout time.Duration
+	validateReferences     bool
+}
+
+type roleMembershipResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	RoleID      types.String   `tfsdk:"role_id"`
+	IdentityIDs []types.String `tfsdk:"identity_ids"`
+}
+
+func (r *roleMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_membership"
+}
+
+func (r *roleMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     stateVersion(roleMembershipStateUpgrades),
+		Description: "Assigns a role directly to a list of identities, for break-glass and service roles granted outside of birthright criteria. The role membership is replaced by the identity list, so identities added or removed outside of Terraform are reconciled in the next apply. Roles whose membership is criteria based are rejected on create, and destroying the resource removes the membership of the role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the role.",
+			},
+			"role_id": schema.StringAttribute{
+				Required:      true,
+				Validators:    []validator.String{sailPointIDValidator{}},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the role.",
+			},
+			"identity_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.Set{sailPointIDValidator{}},
+				Description: "IDs of the identities the role is assigned to.",
+			},
+		},
+	}
+}
+
+func (r *roleMembershipResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(roleMembershipStateUpgrades)
+}
+
+func (r *roleMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint RoleMembership resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client()
+	r.rateLimit = data.rateLimit
+	r.validateReferences = data.validateReferences
+	r.readAfterCreateTimeout = data.readAfterCreateTimeout
+}
+
+func (r *roleMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing references other objects in destroy plans.
+	if !r.validateReferences || req.Plan.Raw.IsNull() {
+		return
+	}
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	references := planReferences(ctx, req, map[string]referenceKind{
+		"identity_ids": identityReference,
+		"role_id":      roleReference,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkReferences(ctx, r.client, references, &resp.Diagnostics)
+}
+
+func (r *roleMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating role membership resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan roleMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The role may have been created in the same apply, and not be visible
+	// yet.
+	role, res, err := readAfterCreate(ctx, r.readAfterCreateTimeout, func(ctx context.Context) (*api_v2025.Role, *http.Response, error) {
+		return r.client.V2025.RolesAPI.GetRole(ctx, plan.RoleID.ValueString()).Execute()
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Role", err, res)
+		return
+	}
+
+	// Replacing criteria would silently revoke the role from every identity
+	// matching them.
+	if membership, ok := role.GetMembershipOk(); ok && membership.GetType() == api_v2025.ROLEMEMBERSHIPSELECTORTYPE_STANDARD {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_id"),
+			"Role Membership Is Criteria Based",
+			fmt.Sprintf("The membership of role %s is defined by criteria, remove them from the role before assigning it to a list of identities.", plan.RoleID.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setMembership(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID is the ID of the role, it has a single membership.
+	plan.ID = plan.RoleID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIDIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+func (r *roleMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading role membership resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var state roleMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, res, err := r.client.V2025.RolesAPI.GetRole(ctx, state.ID.ValueString()).Execute()
+	if res != nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Role", err, res)
+		return
+	}
+
+	state.RoleID = types.StringValue(role.GetId())
+	state.IdentityIDs = roleMembershipIdentityIDs(role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	setIDIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
+}
+
+func (r *roleMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating role membership resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan roleMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setMembership(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIDIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+func (r *roleMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting role membership resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var state roleMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := api_v2025.NewJsonPatchOperation("remove", "/membership")
+	_, res, err := r.client.V2025.RolesAPI.PatchRole(ctx, state.ID.ValueString()).JsonPatchOperation([]api_v2025.JsonPatchOperation{*operation}).Execute()
+	if err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Remove Role Membership", err, res)
+	}
+}
+
+func (r *roleMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
+// ImportState imports the membership of a role by the ID of the role, or by
+// identity.
+func (r *roleMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// setMembership replaces the membership of the role with the identity list of
+// plan.
+func (r *roleMembershipResource) setMembership(ctx context.Context, plan roleMembershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Replacing role membership", map[string]any{"role_id": plan.RoleID.ValueString(), "count": len(plan.IdentityIDs)})
+
+	operation := roleMembershipOperation(plan.IdentityIDs)
+	_, res, err := r.client.V2025.RolesAPI.PatchRole(ctx, plan.RoleID.ValueString()).JsonPatchOperation([]api_v2025.JsonPatchOperation{operation}).Execute()
+	if err != nil {
+		addAPIError(ctx, &diags, "Unable to Update Role Membership", err, res)
+	}
+	return diags
+}
+
+// roleMembershipOperation returns the patch operation setting the membership
+// of a role to a list of identities.
+func roleMembershipOperation(identityIDs []types.String) api_v2025.JsonPatchOperation {
+	identities := make([]interface{}, 0, len(identityIDs))
+	for _, id := range identityIDs {
+		identities = append(identities, map[string]interface{}{"type": string(api_v2025.DTOTYPE_IDENTITY), "id": id.ValueString()})
+	}
+	membership := map[string]interface{}{
+		"type":       string(api_v2025.ROLEMEMBERSHIPSELECTORTYPE_IDENTITY_LIST),
+		"identities": identities,
+	}
+
+	operation := api_v2025.NewJsonPatchOperation("replace", "/membership")
+	operation.Value = &api_v2025.UpdateMultiHostSourcesRequestInnerValue{MapmapOfStringAny: &membership}
+	return *operation
+}
+
+// roleMembershipIdentityIDs returns the IDs of the identities the role is
+// directly assigned to, none when its membership is criteria based.
+func roleMembershipIdentityIDs(role *api_v2025.Role) []types.String {
+	ids := make([]types.String, 0)
+	membership, ok := role.GetMembershipOk()
+	if !ok || membership == nil || membership.GetType() != api_v2025.ROLEMEMBERSHIPSELECTORTYPE_IDENTITY_LIST {
+		return ids
+	}
+	for _, identity := range membership.GetIdentities() {
+		ids = append(ids, types.StringValue(identity.GetId()))
+	}
+	return ids
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestRoleMembershipIdentityIDs(t *testing.T) {
+	for name, test := range map[string]struct {
+		membership string
+		expected   []string
+	}{
+		"identity list": {`{"type": "IDENTITY_LIST", "identities": [{"type": "IDENTITY", "id": "i1"}, {"type": "IDENTITY", "id": "i2"}]}`, []string{"i1", "i2"}},
+		"criteria":      {`{"type": "STANDARD", "criteria": {"operation": "EQUALS"}}`, []string{}},
+		"none":          {`null`, []string{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var role api_v2025.Role
+			body := `{"id": "r1", "name": "Break Glass", "owner": {"type": "IDENTITY", "id": "o1"}, "membership": ` + test.membership + `}`
+			if err := json.Unmarshal([]byte(body), &role); err != nil {
+				t.Fatal(err)
+			}
+
+			ids := roleMembershipIdentityIDs(&role)
+			if len(ids) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, ids)
+			}
+			for i, id := range test.expected {
+				if ids[i].ValueString() != id {
+					t.Errorf("expected %v, got %v", test.expected, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestRoleMembershipOperation(t *testing.T) {
+	operation := roleMembershipOperation([]types.String{types.StringValue("i1")})
+	body, err := json.Marshal(operation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"op":"replace","path":"/membership","value":{"identities":[{"id":"i1","type":"IDENTITY"}],"type":"IDENTITY_LIST"}}`
+	if string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+}
+
+func TestRoleMembershipImportStateByIdentity(t *testing.T) {
+	ctx := context.Background()
+	r := NewRoleMembershipResource()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	identityType := idIdentitySchema.Type().TerraformType(ctx)
+
+	req := resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: idIdentitySchema,
+			Raw:    tftypes.NewValue(identityType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "r1")}),
+		},
+	}
+	resp := resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.(resource.ResourceWithImportState).ImportState(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if id.ValueString() != "r1" {
+		t.Errorf("expected r1, got %s", id)
+	}
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	roleMiningEntitlementAttrTypes = map[string]attr.Type{
+		"id":               types.StringType,
+		"name":             types.StringType,
+		"attribute":        types.StringType,
+		"source_id":        types.StringType,
+		"application_name": types.StringType,
+		"identity_count":   types.Int32Type,
+		"popularity":       types.Float32Type,
+	}
+	roleMiningSessionDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the role mining session.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name the session was saved with.",
+		},
+		"type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the roles mined by the session: SPECIALIZED or COMMON.",
+		},
+		"saved": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the session is saved.",
+		},
+		"scope_criteria": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Search criteria of the identities the session mines roles from.",
+		},
+		"scope_identity_ids": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "IDs of the identities the session mines roles from, when it is scoped by identity list.",
+		},
+		"identity_count": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Number of identities in the scope of the session.",
+		},
+		"prune_threshold": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Percentage of the identities of a potential role below which entitlements are left out of it.",
+		},
+		"min_num_identities_in_potential_role": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Minimum number of identities in a potential role.",
+		},
+		"potential_role_count": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Number of potential roles mined by the session.",
+		},
+		"potential_roles_ready_count": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Number of potential roles whose processing is done.",
+		},
+	}
+	roleMiningPotentialRoleDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the potential role.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the potential role.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the potential role.",
+		},
+		"session_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the role mining session which mined the potential role.",
+		},
+		"type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the potential role: SPECIALIZED or COMMON.",
+		},
+		"identity_count": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Number of identities the potential role would be assigned to.",
+		},
+		"entitlement_count": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Number of entitlements of the potential role.",
+		},
+		"density": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Percentage of the entitlements of the potential role its identities have.",
+		},
+		"freshness": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Freshness of the potential role, as a percentage.",
+		},
+		"quality": dataSchema.Int32Attribute{
+			Computed:    true,
+			Description: "Quality of the potential role, as a percentage.",
+		},
+		"saved": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the potential role is saved.",
+		},
+		"provision_state": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "State of the creation of a role from the potential role: POTENTIAL, PENDING, COMPLETE or FAILED.",
+		},
+		"role_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the role created from the potential role, if any.",
+		},
+		"entitlements": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: roleMiningEntitlementAttrTypes},
+			Description: "Entitlements of the potential role, only set when include_entitlements is enabled",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the potential role.",
+		},
+	}
+)
+
+type roleMiningSessionModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	Type                            types.String `tfsdk:"type"`
+	Saved                           types.Bool   `tfsdk:"saved"`
+	ScopeCriteria                   types.String `tfsdk:"scope_criteria"`
+	ScopeIdentityIDs                types.List   `tfsdk:"scope_identity_ids"`
+	IdentityCount                   types.Int32  `tfsdk:"identity_count"`
+	PruneThreshold                  types.Int32  `tfsdk:"prune_threshold"`
+	MinNumIdentitiesInPotentialRole types.Int32  `tfsdk:"min_num_identities_in_potential_role"`
+	PotentialRoleCount              types.Int32  `tfsdk:"potential_role_count"`
+	PotentialRolesReadyCount        types.Int32  `tfsdk:"potential_roles_ready_count"`
+}
+
+type roleMiningPotentialRoleModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	SessionID        types.String `tfsdk:"session_id"`
+	Type             types.String `tfsdk:"type"`
+	IdentityCount    types.Int32  `tfsdk:"identity_count"`
+	EntitlementCount types.Int32  `tfsdk:"entitlement_count"`
+	Density          types.Int32  `tfsdk:"density"`
+	Freshness        types.Int32  `tfsdk:"freshness"`
+	Quality          types.Int32  `tfsdk:"quality"`
+	Saved            types.Bool   `tfsdk:"saved"`
+	ProvisionState   types.String `tfsdk:"provision_state"`
+	RoleID           types.String `tfsdk:"role_id"`
+	Entitlements     types.List   `tfsdk:"entitlements"`
+	Created          rfc3339Value `tfsdk:"created"`
+}
+
+type roleMiningEntitlementModel struct {
+	ID              types.String  `tfsdk:"id"`
+	Name            types.String  `tfsdk:"name"`
+	Attribute       types.String  `tfsdk:"attribute"`
+	SourceID        types.String  `tfsdk:"source_id"`
+	ApplicationName types.String  `tfsdk:"application_name"`
+	IdentityCount   types.Int32   `tfsdk:"identity_count"`
+	Popularity      types.Float32 `tfsdk:"popularity"`
+}
+
+type roleMiningSessionsDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters  types.String             `tfsdk:"sorters"`
+	Filters  types.String             `tfsdk:"filters"`
+	Sessions []roleMiningSessionModel `tfsdk:"sessions"`
+}
+
+type roleMiningPotentialRolesDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	SessionID           types.String                   `tfsdk:"session_id"`
+	Sorters             types.String                   `tfsdk:"sorters"`
+	Filters             types.String                   `tfsdk:"filters"`
+	IncludeEntitlements types.Bool                     `tfsdk:"include_entitlements"`
+	PotentialRoles      []roleMiningPotentialRoleModel `tfsdk:"potential_roles"`
+}
+
+func serializeRoleMiningSessionData(ctx context.Context, session api_v2025.RoleMiningSessionDto) (roleMiningSessionModel, diag.Diagnostics) {
+	// The list endpoint returns the ID of the sessions, which its schema
+	// doesn't declare, so the SDK keeps it with the undeclared properties.
+	id := types.StringNull()
+	if value, ok := session.AdditionalProperties["id"].(string); ok {
+		id = types.StringValue(value)
+	}
+
+	scope := session.GetScope()
+	identityIDs, diags := types.ListValueFrom(ctx, types.StringType, scope.IdentityIds)
+	if diags.HasError() {
+		return roleMiningSessionModel{}, diags
+	}
+
+	roleType := types.StringNull()
+	if session.Type != nil {
+		roleType = types.StringValue(string(*session.Type))
+	}
+
+	return roleMiningSessionModel{
+		ID:                              id,
+		Name:                            types.StringPointerValue(session.Name.Get()),
+		Type:                            roleType,
+		Saved:                           types.BoolPointerValue(session.Saved),
+		ScopeCriteria:                   types.StringPointerValue(scope.Criteria.Get()),
+		ScopeIdentityIDs:                identityIDs,
+		IdentityCount:                   types.Int32PointerValue(session.IdentityCount),
+		PruneThreshold:                  types.Int32PointerValue(session.PruneThreshold.Get()),
+		MinNumIdentitiesInPotentialRole: types.Int32PointerValue(session.MinNumIdentitiesInPotentialRole.Get()),
+		PotentialRoleCount:              types.Int32PointerValue(session.PotentialRoleCount),
+		PotentialRolesReadyCount:        types.Int32PointerValue(session.PotentialRolesReadyCount),
+	}, nil
+}
+
+func serializeRoleMiningPotentialRoleData(role api_v2025.RoleMiningPotentialRoleSummary) roleMiningPotentialRoleModel {
+	roleType := types.StringNull()
+	if role.Type != nil {
+		roleType = types.StringValue(string(*role.Type))
+	}
+	provisionState := types.StringNull()
+	if role.ProvisionState != nil {
+		provisionState = types.StringValue(string(*role.ProvisionState))
+	}
+
+	return roleMiningPotentialRoleModel{
+		ID:               types.StringPointerValue(role.Id),
+		Name:             types.StringPointerValue(role.Name),
+		Description:      types.StringPointerValue(role.Description.Get()),
+		SessionID:        types.StringPointerValue(role.GetSession().Id),
+		Type:             roleType,
+		IdentityCount:    types.Int32PointerValue(role.IdentityCount),
+		EntitlementCount: types.Int32PointerValue(role.EntitlementCount),
+		Density:          types.Int32PointerValue(role.Density),
+		Freshness:        types.Int32PointerValue(role.Freshness),
+		Quality:          types.Int32PointerValue(role.Quality),
+		Saved:            types.BoolPointerValue(role.Saved),
+		ProvisionState:   provisionState,
+		RoleID:           types.StringPointerValue(role.RoleId.Get()),
+		Entitlements:     types.ListNull(types.ObjectType{AttrTypes: roleMiningEntitlementAttrTypes}),
+		Created:          sailPointTimeValue(role.CreatedDate),
+	}
+}
+
+func serializeRoleMiningEntitlements(ctx context.Context, entitlements []api_v2025.RoleMiningEntitlement) (types.List, diag.Diagnostics) {
+	models := make([]roleMiningEntitlementModel, 0, len(entitlements))
+	for _, entitlement := range entitlements {
+		ref := entitlement.GetEntitlementRef()
+		models = append(models, roleMiningEntitlementModel{
+			ID:              types.StringPointerValue(ref.Id),
+			Name:            types.StringPointerValue(entitlement.Name),
+			Attribute:       types.StringPointerValue(ref.Attribute),
+			SourceID:        types.StringPointerValue(entitlement.SourceId),
+			ApplicationName: types.StringPointerValue(entitlement.ApplicationName),
+			IdentityCount:   types.Int32PointerValue(entitlement.IdentityCount),
+			Popularity:      types.Float32PointerValue(entitlement.Popularity),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: roleMiningEntitlementAttrTypes}, models)
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource                   = &roleMiningPotentialRolesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &roleMiningPotentialRolesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &roleMiningPotentialRolesDataSource{}
+)
+
+func NewRoleMiningPotentialRolesDataSource() datasource.DataSource {
+	return &roleMiningPotentialRolesDataSource{}
+}
+
+type roleMiningPotentialRolesDataSource struct {
+	data *providerData
+}
+
+func (d *roleMiningPotentialRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_mining_potential_roles"
+}
+
+func (d *roleMiningPotentialRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the potential roles mined by IAI role mining sessions, the candidate roles whose entitlements can be used to create roles.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"session_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the role mining session whose potential roles are listed. The potential roles of every session are listed when unset.",
+			},
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters",
+			},
+			"include_entitlements": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to fetch the entitlements of each potential role (one extra request per potential role)",
+			},
+			"potential_roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Potential roles of the sessions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: roleMiningPotentialRoleDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *roleMiningPotentialRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint RoleMiningPotentialRoles data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// ValidateConfig rejects the data source at plan time when the provider
+// doesn't enable experimental APIs.
+func (d *roleMiningPotentialRolesDataSource) ValidateConfig(_ context.Context, _ datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	d.data.requireExperimental("sailpoint_role_mining_potential_roles", &resp.Diagnostics)
+}
+
+func (d *roleMiningPotentialRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Role Mining Potential Roles")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state roleMiningPotentialRolesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !d.data.experimentalEnabled(client, "sailpoint_role_mining_potential_roles", &resp.Diagnostics) {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Role Mining Potential Roles filters", map[string]any{"filters": filters})
+
+	var results []v2025.RoleMiningPotentialRoleSummary
+	var res *http.Response
+	var err error
+	if sessionID := state.SessionID.ValueString(); sessionID != "" {
+		request := client.V2025.IAIRoleMiningAPI.GetPotentialRoleSummaries(ctx, sessionID)
+		if filters != "" {
+			request = request.Filters(filters)
+		}
+		if !state.Sorters.IsNull() {
+			request = request.Sorters(state.Sorters.ValueString())
+		}
+		results, res, err = paginate[v2025.RoleMiningPotentialRoleSummary](request, &state.paginationModel)
+	} else {
+		request := client.V2025.IAIRoleMiningAPI.GetAllPotentialRoleSummaries(ctx)
+		if filters != "" {
+			request = request.Filters(filters)
+		}
+		if !state.Sorters.IsNull() {
+			request = request.Sorters(state.Sorters.ValueString())
+		}
+		results, res, err = paginate[v2025.RoleMiningPotentialRoleSummary](request, &state.paginationModel)
+	}
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Role Mining Potential Roles", err, res)
+		return
+	}
+
+	state.PotentialRoles = make([]roleMiningPotentialRoleModel, 0, len(results))
+	for _, role := range results {
+		state.PotentialRoles = append(state.PotentialRoles, serializeRoleMiningPotentialRoleData(role))
+	}
+
+	if state.IncludeEntitlements.ValueBool() {
+		entitlements := make([][]v2025.RoleMiningEntitlement, len(state.PotentialRoles))
+		responses := make([]*http.Response, len(state.PotentialRoles))
+		failed, err := forEachConcurrently(ctx, len(state.PotentialRoles), detailFetchWorkers, func(ctx context.Context, i int) error {
+			role := state.PotentialRoles[i]
+			var err error
+			entitlements[i], responses[i], err = sailpoint.PaginateWithDefaults[v2025.RoleMiningEntitlement](client.V2025.IAIRoleMiningAPI.GetEntitlementsPotentialRole(ctx, role.SessionID.ValueString(), role.ID.ValueString()))
+			return err
+		})
+		if err != nil {
+			var res *http.Response
+			roleID := ""
+			if failed >= 0 {
+				res, roleID = responses[failed], state.PotentialRoles[failed].ID.ValueString()
+			}
+			resp.Diagnostics.AddError(
+				"Unable to Read Role Mining Potential Roles",
+				fmt.Sprintf("Reading entitlements of potential role %s: %s", roleID, describeAPIError(ctx, "Unable to Read Role Mining Potential Roles", err, res)),
+			)
+			return
+		}
+
+		for i := range state.PotentialRoles {
+			var diags diag.Diagnostics
+			state.PotentialRoles[i].Entitlements, diags = serializeRoleMiningEntitlements(ctx, entitlements[i])
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource                   = &roleMiningSessionsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &roleMiningSessionsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &roleMiningSessionsDataSource{}
+)
+
+func NewRoleMiningSessionsDataSource() datasource.DataSource {
+	return &roleMiningSessionsDataSource{}
+}
+
+type roleMiningSessionsDataSource struct {
+	data *providerData
+}
+
+func (d *roleMiningSessionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_mining_sessions"
+}
+
+func (d *roleMiningSessionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the IAI role mining sessions of the tenant, whose potential roles are read with the sailpoint_role_mining_potential_roles data source.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters, on saved and name (ex. saved eq true)",
+			},
+			"sessions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Role mining sessions of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: roleMiningSessionDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *roleMiningSessionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint RoleMiningSessions data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// ValidateConfig rejects the data source at plan time when the provider
+// doesn't enable experimental APIs.
+func (d *roleMiningSessionsDataSource) ValidateConfig(_ context.Context, _ datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	d.data.requireExperimental("sailpoint_role_mining_sessions", &resp.Diagnostics)
+}
+
+func (d *roleMiningSessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Role Mining Sessions")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state roleMiningSessionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !d.data.experimentalEnabled(client, "sailpoint_role_mining_sessions", &resp.Diagnostics) {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Role Mining Sessions filters", map[string]any{"filters": filters})
+
+	request := client.V2025.IAIRoleMiningAPI.GetRoleMiningSessions(ctx)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+	if !state.Sorters.IsNull() {
+		request = request.Sorters(state.Sorters.ValueString())
+	}
+
+	results, res, err := paginate[v2025.RoleMiningSessionDto](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Role Mining Sessions", err, res)
+		return
+	}
+
+	state.Sessions = make([]roleMiningSessionModel, 0, len(results))
+	for _, session := range results {
+		sessionState, diags := serializeRoleMiningSessionData(ctx, session)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Sessions = append(state.Sessions, sessionState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
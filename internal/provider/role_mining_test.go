@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestSerializeRoleMiningSessionData(t *testing.T) {
+	var session api_v2025.RoleMiningSessionDto
+	if err := json.Unmarshal([]byte(`{
+		"id": "8c190e67-87aa-4ed9-a90b-d9d5344523fb",
+		"name": "Engineering",
+		"type": "SPECIALIZED",
+		"scope": {"criteria": "source.name:DataScienceDataset"},
+		"pruneThreshold": 50,
+		"potentialRoleCount": 8
+	}`), &session); err != nil {
+		t.Fatal(err)
+	}
+
+	model, diags := serializeRoleMiningSessionData(context.Background(), session)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if model.ID.ValueString() != "8c190e67-87aa-4ed9-a90b-d9d5344523fb" || model.Type.ValueString() != "SPECIALIZED" {
+		t.Errorf("unexpected session %+v", model)
+	}
+	if model.ScopeCriteria.ValueString() != "source.name:DataScienceDataset" || !model.ScopeIdentityIDs.IsNull() || model.PruneThreshold.ValueInt32() != 50 {
+		t.Errorf("unexpected scope %+v", model)
+	}
+}
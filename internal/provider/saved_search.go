@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	savedSearchDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the saved search.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the saved search.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the saved search.",
+		},
+		"indices": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Indices searched, such as identities or accounts.",
+		},
+		"query": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Query of the search.",
+		},
+		"fields": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Fields the query is applied to.",
+		},
+		"sort": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Fields the results are sorted by.",
+		},
+		"owner": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: typedReferenceAttrTypes,
+			Description:    "Owner of the saved search.",
+		},
+		"public": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the saved search is visible to every user.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the saved search.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the saved search.",
+		},
+	}
+)
+
+type savedSearchModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Indices     types.List   `tfsdk:"indices"`
+	Query       types.String `tfsdk:"query"`
+	Fields      types.List   `tfsdk:"fields"`
+	Sort        types.List   `tfsdk:"sort"`
+	Owner       types.Object `tfsdk:"owner"`
+	Public      types.Bool   `tfsdk:"public"`
+	Created     rfc3339Value `tfsdk:"created"`
+	Modified    rfc3339Value `tfsdk:"modified"`
+}
+
+type savedSearchesDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Filters       types.String       `tfsdk:"filters"`
+	SavedSearches []savedSearchModel `tfsdk:"saved_searches"`
+}
+
+func serializeSavedSearchData(ctx context.Context, search api_v2025.SavedSearch) (savedSearchModel, diag.Diagnostics) {
+	indices, diags := types.ListValueFrom(ctx, types.StringType, search.Indices)
+	if diags.HasError() {
+		return savedSearchModel{}, diags
+	}
+
+	fields, diags := types.ListValueFrom(ctx, types.StringType, search.Fields)
+	if diags.HasError() {
+		return savedSearchModel{}, diags
+	}
+
+	sort, diags := types.ListValueFrom(ctx, types.StringType, search.Sort)
+	if diags.HasError() {
+		return savedSearchModel{}, diags
+	}
+
+	owner := types.ObjectNull(typedReferenceAttrTypes)
+	if search.Owner != nil {
+		owner, diags = types.ObjectValueFrom(ctx, typedReferenceAttrTypes, typedReferenceModel{
+			Type: types.StringValue(string(search.Owner.GetType())),
+			ID:   types.StringValue(search.Owner.GetId()),
+		})
+		if diags.HasError() {
+			return savedSearchModel{}, diags
+		}
+	}
+
+	return savedSearchModel{
+		ID:          types.StringPointerValue(search.Id),
+		Name:        types.StringPointerValue(search.Name),
+		Description: types.StringPointerValue(search.Description.Get()),
+		Indices:     indices,
+		Query:       types.StringValue(search.GetQuery()),
+		Fields:      fields,
+		Sort:        sort,
+		Owner:       owner,
+		Public:      types.BoolPointerValue(search.Public),
+		Created:     sailPointTimeValue(search.Created.Get()),
+		Modified:    sailPointTimeValue(search.Modified.Get()),
+	}, nil
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	scheduledSearchDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the scheduled search.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the scheduled search.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description of the scheduled search.",
+		},
+		"saved_search_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the saved search run on schedule.",
+		},
+		"schedule": dataSchema.StringAttribute{
+			CustomType:  normalizedJSONType{},
+			Computed:    true,
+			Description: "JSON encoded schedule of the search",
+		},
+		"recipients": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.ObjectType{AttrTypes: typedReferenceAttrTypes},
+			Description: "Identities the results are emailed to.",
+		},
+		"enabled": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the scheduled search is enabled.",
+		},
+		"email_empty_results": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the results are emailed when the search returns nothing.",
+		},
+		"display_query_details": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the email shows the query of the search.",
+		},
+		"owner": dataSchema.ObjectAttribute{
+			Computed:       true,
+			AttributeTypes: typedReferenceAttrTypes,
+			Description:    "Owner of the scheduled search.",
+		},
+		"created": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Creation date of the scheduled search.",
+		},
+		"modified": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Last modification date of the scheduled search.",
+		},
+	}
+)
+
+type scheduledSearchModel struct {
+	ID                  types.String        `tfsdk:"id"`
+	Name                types.String        `tfsdk:"name"`
+	Description         types.String        `tfsdk:"description"`
+	SavedSearchID       types.String        `tfsdk:"saved_search_id"`
+	Schedule            normalizedJSONValue `tfsdk:"schedule"`
+	Recipients          types.List          `tfsdk:"recipients"`
+	Enabled             types.Bool          `tfsdk:"enabled"`
+	EmailEmptyResults   types.Bool          `tfsdk:"email_empty_results"`
+	DisplayQueryDetails types.Bool          `tfsdk:"display_query_details"`
+	Owner               types.Object        `tfsdk:"owner"`
+	Created             rfc3339Value        `tfsdk:"created"`
+	Modified            rfc3339Value        `tfsdk:"modified"`
+}
+
+type scheduledSearchesDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Filters           types.String           `tfsdk:"filters"`
+	ScheduledSearches []scheduledSearchModel `tfsdk:"scheduled_searches"`
+}
+
+func serializeScheduledSearchData(ctx context.Context, search api_v2025.ScheduledSearch) (scheduledSearchModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scheduleBytes, err := json.Marshal(search.Schedule)
+	if err != nil {
+		diags.AddError(
+			"Unable to serialize scheduled search schedule",
+			err.Error(),
+		)
+		return scheduledSearchModel{}, diags
+	}
+
+	recipients := make([]typedReferenceModel, 0, len(search.Recipients))
+	for _, recipient := range search.Recipients {
+		recipients = append(recipients, typedReferenceModel{
+			Type: types.StringValue(recipient.GetType()),
+			ID:   types.StringValue(recipient.GetId()),
+		})
+	}
+
+	recipientsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: typedReferenceAttrTypes}, recipients)
+	if diags.HasError() {
+		return scheduledSearchModel{}, diags
+	}
+
+	owner, diags := types.ObjectValueFrom(ctx, typedReferenceAttrTypes, typedReferenceModel{
+		Type: types.StringValue(search.Owner.GetType()),
+		ID:   types.StringValue(search.Owner.GetId()),
+	})
+	if diags.HasError() {
+		return scheduledSearchModel{}, diags
+	}
+
+	return scheduledSearchModel{
+		ID:                  types.StringValue(search.GetId()),
+		Name:                types.StringPointerValue(search.Name.Get()),
+		Description:         types.StringPointerValue(search.Description.Get()),
+		SavedSearchID:       types.StringValue(search.GetSavedSearchId()),
+		Schedule:            normalizedJSONStringValue(string(scheduleBytes)),
+		Recipients:          recipientsList,
+		Enabled:             types.BoolPointerValue(search.Enabled),
+		EmailEmptyResults:   types.BoolPointerValue(search.EmailEmptyResults),
+		DisplayQueryDetails: types.BoolPointerValue(search.DisplayQueryDetails),
+		Owner:               owner,
+		Created:             sailPointTimeValue(search.Created.Get()),
+		Modified:            sailPointTimeValue(search.Modified.Get()),
+	}, nil
+}
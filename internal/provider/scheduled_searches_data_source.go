@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &scheduledSearchesDataSource{}
+	_ datasource.DataSourceWithConfigure = &scheduledSearchesDataSource{}
+)
+
+func NewScheduledSearchesDataSource() datasource.DataSource {
+	return &scheduledSearchesDataSource{}
+}
+
+type scheduledSearchesDataSource struct {
+	data *providerData
+}
+
+func (d *scheduledSearchesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_searches"
+}
+
+func (d *scheduledSearchesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the scheduled searches of the tenant, the saved searches whose results are emailed on schedule.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters (supports owner.id eq and savedSearchId eq)",
+			},
+			"scheduled_searches": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Scheduled searches of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduledSearchDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *scheduledSearchesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ScheduledSearches data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *scheduledSearchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Scheduled Searches")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state scheduledSearchesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Scheduled Searches filters", map[string]any{"filters": filters})
+
+	request := client.V2025.ScheduledSearchAPI.ListScheduledSearch(ctx)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+
+	results, res, err := paginate[v2025.ScheduledSearch](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Scheduled Searches", err, res)
+		return
+	}
+
+	state.ScheduledSearches = make([]scheduledSearchModel, 0, len(results))
+	for _, search := range results {
+		searchState, diags := serializeScheduledSearchData(ctx, search)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ScheduledSearches = append(state.ScheduledSearches, searchState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
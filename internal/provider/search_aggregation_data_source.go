@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &searchAggregationDataSource{}
+	_ datasource.DataSourceWithConfigure = &searchAggregationDataSource{}
+)
+
+// The aggregations are named after their kind in the search request, which
+// names the results of aggregations_json.
+const (
+	searchBucketAggregationName = "bucket"
+	searchMetricAggregationName = "metric"
+)
+
+// searchIndexPattern matches the indices of the search.
+var searchIndexPattern = regexp.MustCompile(`^(accessprofiles|accountactivities|entitlements|events|identities|roles|\*)$`)
+
+// searchMetricTypePattern matches the calculations of metric aggregations.
+var searchMetricTypePattern = regexp.MustCompile(`^(COUNT|UNIQUE_COUNT|AVG|SUM|MEDIAN|MIN|MAX)$`)
+
+func NewSearchAggregationDataSource() datasource.DataSource {
+	return &searchAggregationDataSource{}
+}
+
+type searchAggregationDataSource struct {
+	data *providerData
+}
+
+type searchAggregationDataSourceModel struct {
+	APIVersion       types.String                   `tfsdk:"api_version"`
+	Indices          []types.String                 `tfsdk:"indices"`
+	Query            types.String                   `tfsdk:"query"`
+	Bucket           *searchBucketAggregationModel  `tfsdk:"bucket"`
+	Metric           *searchMetricAggregationModel  `tfsdk:"metric"`
+	Buckets          []searchAggregationBucketModel `tfsdk:"buckets"`
+	Value            types.Float64                  `tfsdk:"value"`
+	AggregationsJSON normalizedJSONValue            `tfsdk:"aggregations_json"`
+}
+
+type searchBucketAggregationModel struct {
+	Field       types.String `tfsdk:"field"`
+	Size        types.Int64  `tfsdk:"size"`
+	MinDocCount types.Int64  `tfsdk:"min_doc_count"`
+}
+
+type searchMetricAggregationModel struct {
+	Type  types.String `tfsdk:"type"`
+	Field types.String `tfsdk:"field"`
+}
+
+type searchAggregationBucketModel struct {
+	Key   types.String  `tfsdk:"key"`
+	Count types.Int64   `tfsdk:"count"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+// searchAggregationResult is the part of the Elasticsearch aggregation
+// results read into buckets and value.
+type searchAggregationResult struct {
+	Bucket *struct {
+		Buckets []struct {
+			Key      any                     `json:"key"`
+			DocCount int64                   `json:"doc_count"`
+			Metric   searchMetricResultValue `json:"metric"`
+		} `json:"buckets"`
+	} `json:"bucket"`
+	Metric searchMetricResultValue `json:"metric"`
+}
+
+type searchMetricResultValue struct {
+	Value *float64 `json:"value"`
+}
+
+func (d *searchAggregationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_aggregation"
+}
+
+func (d *searchAggregationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Aggregates the documents matching a search, such as the number of identities per lifecycle state, without reading the documents themselves. The documents are grouped in buckets by the value of a field, a metric being calculated over each bucket, or over all the documents without bucket.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"indices": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{stringPatternValidator{pattern: searchIndexPattern, message: "must be one of accessprofiles, accountactivities, entitlements, events, identities, roles or *"}},
+				Description: "Indices searched, such as identities or roles.",
+			},
+			"query": schema.StringAttribute{
+				Optional:    true,
+				Description: "Query selecting the documents aggregated, in the Elasticsearch query string syntax (ex. attributes.cloudLifecycleState:active). Defaults to *, every document.",
+			},
+			"bucket": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Groups the documents by the value of a field.",
+				Attributes: map[string]schema.Attribute{
+					"field": schema.StringAttribute{
+						Required:    true,
+						Description: "Field the documents are grouped by (ex. attributes.cloudLifecycleState), prefixed with @ for the fields of nested objects.",
+					},
+					"size": schema.Int64Attribute{
+						Optional:    true,
+						Validators:  []validator.Int64{int64RangeValidator{min: 1, max: 10000}},
+						Description: "Maximum number of buckets, those with the most documents being kept. Defaults to the API default of 100.",
+					},
+					"min_doc_count": schema.Int64Attribute{
+						Optional:    true,
+						Validators:  []validator.Int64{int64RangeValidator{min: 0, max: 10000}},
+						Description: "Minimum number of documents of the buckets returned. Defaults to 1.",
+					},
+				},
+			},
+			"metric": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Calculates a metric over the documents of each bucket, or over all the documents without bucket.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Validators:  []validator.String{stringPatternValidator{pattern: searchMetricTypePattern, message: "must be one of COUNT, UNIQUE_COUNT, AVG, SUM, MEDIAN, MIN or MAX"}},
+						Description: "Calculation of the metric: COUNT, UNIQUE_COUNT, AVG, SUM, MEDIAN, MIN or MAX.",
+					},
+					"field": schema.StringAttribute{
+						Required:    true,
+						Description: "Field the metric is calculated on, prefixed with @ for the fields of nested objects.",
+					},
+				},
+			},
+			"buckets": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Buckets of the documents, by decreasing number of documents. Empty without bucket.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Value of the field of the documents of the bucket.",
+						},
+						"count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of documents of the bucket.",
+						},
+						"value": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Metric of the documents of the bucket, null without metric.",
+						},
+					},
+				},
+			},
+			"value": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Metric of all the documents, null with a bucket or without metric.",
+			},
+			"aggregations_json": schema.StringAttribute{
+				CustomType:  normalizedJSONType{},
+				Computed:    true,
+				Description: "JSON encoded aggregation results as returned by the API, the bucket and metric aggregations being named bucket and metric.",
+			},
+		},
+	}
+}
+
+func (d *searchAggregationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SearchAggregation data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *searchAggregationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Search Aggregation")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state searchAggregationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	search := state.searchRequest()
+	tflog.Debug(ctx, "Aggregating search", map[string]any{"search": search})
+
+	result, res, err := client.V2025.SearchAPI.SearchAggregate(ctx).Search(search).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Aggregate Search", err, res)
+		return
+	}
+
+	aggregations, err := json.Marshal(result.Aggregations)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Aggregate Search", err.Error())
+		return
+	}
+	state.AggregationsJSON = normalizedJSONStringValue(string(aggregations))
+	state.Buckets, state.Value, err = serializeSearchAggregations(aggregations, state.Metric != nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Aggregate Search",
+			fmt.Sprintf("The aggregation results could not be read: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// searchRequest returns the aggregation search of the configuration.
+func (m searchAggregationDataSourceModel) searchRequest() api_v2025.Search {
+	search := api_v2025.Search{
+		Indices: make([]api_v2025.Index, 0, len(m.Indices)),
+		Query:   &api_v2025.Query{Query: api_v2025.PtrString("*")},
+	}
+	for _, index := range m.Indices {
+		search.Indices = append(search.Indices, api_v2025.Index(index.ValueString()))
+	}
+	if !m.Query.IsNull() {
+		search.Query.Query = m.Query.ValueStringPointer()
+	}
+
+	aggregationType := api_v2025.AGGREGATIONTYPE_SAILPOINT
+	search.AggregationType = &aggregationType
+	search.Aggregations = &api_v2025.SearchAggregationSpecification{}
+	if m.Bucket != nil {
+		bucket := api_v2025.NewBucketAggregation(searchBucketAggregationName, m.Bucket.Field.ValueString())
+		bucket.SetType(api_v2025.BUCKETTYPE_TERMS)
+		if !m.Bucket.Size.IsNull() {
+			bucket.SetSize(int32(m.Bucket.Size.ValueInt64()))
+		}
+		if !m.Bucket.MinDocCount.IsNull() {
+			bucket.SetMinDocCount(int32(m.Bucket.MinDocCount.ValueInt64()))
+		}
+		search.Aggregations.Bucket = bucket
+	}
+	if m.Metric != nil {
+		metric := api_v2025.NewMetricAggregation(searchMetricAggregationName, m.Metric.Field.ValueString())
+		metric.SetType(api_v2025.MetricType(m.Metric.Type.ValueString()))
+		search.Aggregations.Metric = metric
+	}
+	return search
+}
+
+// serializeSearchAggregations reads the buckets and the metric of the JSON
+// encoded aggregation results, the metrics being null unless withMetric.
+func serializeSearchAggregations(aggregations []byte, withMetric bool) ([]searchAggregationBucketModel, types.Float64, error) {
+	var result searchAggregationResult
+	if err := json.Unmarshal(aggregations, &result); err != nil {
+		return nil, types.Float64Null(), err
+	}
+
+	buckets := make([]searchAggregationBucketModel, 0)
+	if result.Bucket == nil {
+		value := types.Float64Null()
+		if withMetric {
+			value = types.Float64PointerValue(result.Metric.Value)
+		}
+		return buckets, value, nil
+	}
+
+	for _, bucket := range result.Bucket.Buckets {
+		key, ok := bucket.Key.(string)
+		if !ok {
+			key = fmt.Sprint(bucket.Key)
+		}
+		model := searchAggregationBucketModel{
+			Key:   types.StringValue(key),
+			Count: types.Int64Value(bucket.DocCount),
+			Value: types.Float64Null(),
+		}
+		if withMetric {
+			model.Value = types.Float64PointerValue(bucket.Metric.Value)
+		}
+		buckets = append(buckets, model)
+	}
+	return buckets, types.Float64Null(), nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSearchAggregationSearchRequest(t *testing.T) {
+	model := searchAggregationDataSourceModel{
+		Indices: []types.String{types.StringValue("identities")},
+		Query:   types.StringNull(),
+		Bucket: &searchBucketAggregationModel{
+			Field:       types.StringValue("attributes.cloudLifecycleState"),
+			Size:        types.Int64Value(10),
+			MinDocCount: types.Int64Null(),
+		},
+		Metric: &searchMetricAggregationModel{
+			Type:  types.StringValue("UNIQUE_COUNT"),
+			Field: types.StringValue("@accounts.source.id"),
+		},
+	}
+
+	body, err := json.Marshal(model.searchRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"aggregationType":"SAILPOINT","aggregations":{"bucket":{"field":"attributes.cloudLifecycleState","name":"bucket","size":10,"type":"TERMS"},"metric":{"field":"@accounts.source.id","name":"metric","type":"UNIQUE_COUNT"}},"indices":["identities"],"query":{"query":"*"}}`
+	if string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+}
+
+func TestSerializeSearchAggregations(t *testing.T) {
+	buckets, value, err := serializeSearchAggregations([]byte(`{"bucket": {"buckets": [
+		{"key": "active", "doc_count": 12, "metric": {"value": 3}},
+		{"key": 1, "doc_count": 2, "metric": {"value": 1}}
+	]}}`), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !value.IsNull() {
+		t.Errorf("expected a null value with buckets, got %s", value)
+	}
+	if len(buckets) != 2 || buckets[0].Key.ValueString() != "active" || buckets[0].Count.ValueInt64() != 12 || buckets[0].Value.ValueFloat64() != 3 || buckets[1].Key.ValueString() != "1" {
+		t.Errorf("unexpected buckets %+v", buckets)
+	}
+
+	buckets, value, err = serializeSearchAggregations([]byte(`{"metric": {"value": 42}}`), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buckets) != 0 || value.ValueFloat64() != 42 {
+		t.Errorf("unexpected buckets %+v and value %s", buckets, value)
+	}
+}
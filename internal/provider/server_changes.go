@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ planmodifier.String = ignoreServerChangesModifier{}
+	_ planmodifier.Bool   = ignoreServerChangesModifier{}
+	_ planmodifier.Int32  = ignoreServerChangesModifier{}
+	_ planmodifier.List   = ignoreServerChangesModifier{}
+)
+
+// ignoreServerChangesResourceSchemaAttribute is the ignore_server_changes
+// attribute of the resources whose objects have attributes the tenant
+// updates on its own, such as versions, statuses and counters.
+var ignoreServerChangesResourceSchemaAttribute = schema.BoolAttribute{
+	Optional:    true,
+	Description: "Whether to keep the values of the attributes the tenant updates on its own (such as versions, statuses and counters) as they were when the object was created or last updated, instead of refreshing them. Defaults to false, they are then refreshed on every read and unknown in the plans of updates.",
+}
+
+// ignoreServerChangesModifier plans the server managed attributes of a
+// resource. When ignore_server_changes is enabled, their planned value is
+// their prior value, which the resource keeps in the state. Otherwise they
+// are left unknown, the tenant may change them at any time so any planned
+// value could be inconsistent with the result of the apply.
+type ignoreServerChangesModifier struct{}
+
+func (m ignoreServerChangesModifier) Description(_ context.Context) string {
+	return "Keeps the prior value when ignore_server_changes is enabled."
+}
+
+func (m ignoreServerChangesModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// ignored reports whether the plan enables ignore_server_changes and the
+// resource has a prior state.
+func (m ignoreServerChangesModifier) ignored(ctx context.Context, plan tfsdk.Plan, stateIsNull bool) bool {
+	if stateIsNull {
+		return false
+	}
+
+	var ignore types.Bool
+	if diags := plan.GetAttribute(ctx, path.Root("ignore_server_changes"), &ignore); diags.HasError() {
+		return false
+	}
+	return ignore.ValueBool()
+}
+
+func (m ignoreServerChangesModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.PlanValue.IsUnknown() && m.ignored(ctx, req.Plan, req.StateValue.IsNull()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m ignoreServerChangesModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.PlanValue.IsUnknown() && m.ignored(ctx, req.Plan, req.StateValue.IsNull()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m ignoreServerChangesModifier) PlanModifyInt32(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+	if req.PlanValue.IsUnknown() && m.ignored(ctx, req.Plan, req.StateValue.IsNull()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m ignoreServerChangesModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.PlanValue.IsUnknown() && m.ignored(ctx, req.Plan, req.StateValue.IsNull()) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &serviceDeskIntegrationTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceDeskIntegrationTemplateDataSource{}
+)
+
+func NewServiceDeskIntegrationTemplateDataSource() datasource.DataSource {
+	return &serviceDeskIntegrationTemplateDataSource{}
+}
+
+type serviceDeskIntegrationTemplateDataSource struct {
+	data *providerData
+}
+
+type serviceDeskIntegrationTemplateDataSourceModel struct {
+	APIVersion                    types.String        `tfsdk:"api_version"`
+	ScriptName                    types.String        `tfsdk:"script_name"`
+	ID                            types.String        `tfsdk:"id"`
+	Name                          types.String        `tfsdk:"name"`
+	Type                          types.String        `tfsdk:"type"`
+	AttributesJSON                normalizedJSONValue `tfsdk:"attributes_json"`
+	UniversalManager              types.Bool          `tfsdk:"universal_manager"`
+	NoProvisioningRequests        types.Bool          `tfsdk:"no_provisioning_requests"`
+	ProvisioningRequestExpiration types.Int64         `tfsdk:"provisioning_request_expiration"`
+	Created                       rfc3339Value        `tfsdk:"created"`
+	Modified                      rfc3339Value        `tfsdk:"modified"`
+}
+
+func (d *serviceDeskIntegrationTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_desk_integration_template"
+}
+
+func (d *serviceDeskIntegrationTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Template of a type of service desk integrations, whose attributes are the ones integrations of the type take, with their default values.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"script_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Script name of the type, as listed by the sailpoint_service_desk_integration_types data source (ex. servicenow).",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the template.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the template.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the integrations using the template.",
+			},
+			"attributes_json": schema.StringAttribute{
+				CustomType:  normalizedJSONType{},
+				Computed:    true,
+				Description: "JSON encoded attributes of the integrations of the type, with their default values.",
+			},
+			"universal_manager": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether integrations of the type manage the provisioning of every source by default.",
+			},
+			"no_provisioning_requests": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether integrations of the type don't save the provisioning requests sent through them by default.",
+			},
+			"provisioning_request_expiration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Default number of hours the saved provisioning requests affect plan compilation.",
+			},
+			"created": schema.StringAttribute{
+				CustomType:  rfc3339Type{},
+				Computed:    true,
+				Description: "Creation date of the template.",
+			},
+			"modified": schema.StringAttribute{
+				CustomType:  rfc3339Type{},
+				Computed:    true,
+				Description: "Last modification date of the template.",
+			},
+		},
+	}
+}
+
+func (d *serviceDeskIntegrationTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ServiceDeskIntegrationTemplate data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *serviceDeskIntegrationTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Service Desk Integration Template")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state serviceDeskIntegrationTemplateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scriptName := state.ScriptName.ValueString()
+	template, res, err := cachedRead(d.data.cache, cacheKey(client, "service-desk-integrations/templates/"+scriptName), func() (*api_v2025.ServiceDeskIntegrationTemplateDto, *http.Response, error) {
+		return client.V2025.ServiceDeskIntegrationAPI.GetServiceDeskIntegrationTemplate(ctx, scriptName).Execute()
+	})
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, fmt.Sprintf("Unable to Read Service Desk Integration Template %q", scriptName), err, res)
+		return
+	}
+
+	attributes, err := json.Marshal(template.Attributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Service Desk Integration Template", err.Error())
+		return
+	}
+
+	state.ID = types.StringPointerValue(template.Id)
+	state.Name = types.StringPointerValue(template.Name.Get())
+	state.Type = types.StringValue(template.Type)
+	state.AttributesJSON = normalizedJSONStringValue(string(attributes))
+	state.UniversalManager = types.BoolPointerValue(template.ProvisioningConfig.UniversalManager)
+	state.NoProvisioningRequests = types.BoolPointerValue(template.ProvisioningConfig.NoProvisioningRequests)
+	state.ProvisioningRequestExpiration = types.Int64Null()
+	if expiration := template.ProvisioningConfig.ProvisioningRequestExpiration; expiration != nil {
+		state.ProvisioningRequestExpiration = types.Int64Value(int64(*expiration))
+	}
+	state.Created = sailPointTimeValue(template.Created)
+	state.Modified = sailPointTimeValue(template.Modified)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServiceDeskIntegrationTemplateDataSourceRead(t *testing.T) {
+	fake := newFakeSailPoint(t)
+	fake.respond(http.MethodGet, "/v2025/service-desk-integrations/templates/servicenow", http.StatusOK, `{
+		"id": "2c9180835d2e5168015d32f890ca1581",
+		"name": "ServiceNow Template",
+		"type": "ServiceNowSDIM",
+		"attributes": {"instance": "", "cluster": "xyzzy999"},
+		"provisioningConfig": {"universalManager": true, "noProvisioningRequests": false, "provisioningRequestExpiration": 7}
+	}`)
+
+	state, diags := readTestDataSource(t, NewServiceDeskIntegrationTemplateDataSource(), fake.providerData(), map[string]tftypes.Value{
+		"script_name": tftypes.NewValue(tftypes.String, "servicenow"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	var model serviceDeskIntegrationTemplateDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if model.Type.ValueString() != "ServiceNowSDIM" || model.AttributesJSON.ValueString() != `{"cluster":"xyzzy999","instance":""}` {
+		t.Errorf("unexpected template %+v", model)
+	}
+	if !model.UniversalManager.ValueBool() || model.ProvisioningRequestExpiration.ValueInt64() != 7 || !model.Created.IsNull() {
+		t.Errorf("unexpected provisioning config %+v", model)
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &serviceDeskIntegrationTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceDeskIntegrationTypesDataSource{}
+)
+
+func NewServiceDeskIntegrationTypesDataSource() datasource.DataSource {
+	return &serviceDeskIntegrationTypesDataSource{}
+}
+
+type serviceDeskIntegrationTypesDataSource struct {
+	data *providerData
+}
+
+type serviceDeskIntegrationTypesDataSourceModel struct {
+	APIVersion types.String                      `tfsdk:"api_version"`
+	Types      []serviceDeskIntegrationTypeModel `tfsdk:"types"`
+}
+
+type serviceDeskIntegrationTypeModel struct {
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	ScriptName types.String `tfsdk:"script_name"`
+}
+
+func (d *serviceDeskIntegrationTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_desk_integration_types"
+}
+
+func (d *serviceDeskIntegrationTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the types of service desk integrations the tenant supports, such as ServiceNow, whose templates are read with the sailpoint_service_desk_integration_template data source.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"types": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Types of service desk integrations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the type.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the integrations, as set on them.",
+						},
+						"script_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Script name of the type, which identifies its template.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *serviceDeskIntegrationTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint ServiceDeskIntegrationTypes data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *serviceDeskIntegrationTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Service Desk Integration Types")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state serviceDeskIntegrationTypesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, res, err := cachedRead(d.data.cache, cacheKey(client, "service-desk-integrations/types"), func() ([]api_v2025.ServiceDeskIntegrationTemplateType, *http.Response, error) {
+		return client.V2025.ServiceDeskIntegrationAPI.GetServiceDeskIntegrationTypes(ctx).Execute()
+	})
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Service Desk Integration Types", err, res)
+		return
+	}
+
+	state.Types = make([]serviceDeskIntegrationTypeModel, 0, len(results))
+	for _, integrationType := range results {
+		state.Types = append(state.Types, serviceDeskIntegrationTypeModel{
+			Name:       types.StringPointerValue(integrationType.Name),
+			Type:       types.StringValue(integrationType.Type),
+			ScriptName: types.StringValue(integrationType.ScriptName),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+)
+
+const sourceAggregationDefaultTimeout = 30 * time.Minute
+
+var (
+	_ resource.Resource                 = &sourceAggregationResource{}
+	_ resource.ResourceWithConfigure    = &sourceAggregationResource{}
+	_ resource.ResourceWithUpgradeState = &sourceAggregationResource{}
+	_ resource.ResourceWithModifyPlan   = &sourceAggregationResource{}
+)
+
+var sourceAggregationStateUpgrades []stateUpgrade
+
+func NewSourceEntitlementAggregationResource() resource.Resource {
+	return &sourceAggregationResource{
+		typeName: "_source_entitlement_aggregation",
+		objects:  "entitlements",
+		start: func(ctx context.Context, client *sailpoint.APIClient, sourceID string, file *os.File) (string, *http.Response, error) {
+			task, res, err := client.V2025.SourcesAPI.ImportEntitlements(ctx, sourceID).File(file).Execute()
+			if err != nil {
+				return "", res, err
+			}
+			return task.GetId(), res, nil
+		},
+	}
+}
+
+func NewSourceAccountAggregationResource() resource.Resource {
+	return &sourceAggregationResource{
+		typeName: "_source_account_aggregation",
+		objects:  "accounts",
+		start: func(ctx context.Context, client *sailpoint.APIClient, sourceID string, file *os.File) (string, *http.Response, error) {
+			task, res, err := client.V2025.SourcesAPI.ImportAccounts(ctx, sourceID).File(file).Execute()
+			if err != nil {
+				return "", res, err
+			}
+			aggregation := task.GetTask()
+			return aggregation.GetId(), res, nil
+		},
+	}
+}
+
+// sourceAggregationResource uploads a file to the aggregation endpoint of a
+// delimited file source, the same resource aggregating its accounts or its
+// entitlements depending on start.
+type sourceAggregationResource struct {
+	client             *sailpoint.APIClient
+	rateLimit          *rateLimitUsage
+	validateReferences bool
+
+	// typeName is the suffix of the type name of the resource.
+	typeName string
+	// objects names the aggregated objects in descriptions and logs.
+	objects string
+	// start uploads the file and returns the ID of the aggregation task.
+	start func(ctx context.Context, client *sailpoint.APIClient, sourceID string, file *os.File) (string, *http.Response, error)
+}
+
+type sourceAggregationResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	SourceID types.String   `tfsdk:"source_id"`
+	File     types.String   `tfsdk:"file"`
+	FileHash types.String   `tfsdk:"file_hash"`
+	Wait     types.Bool     `tfsdk:"wait"`
+	Status   types.String   `tfsdk:"status"`
+	Timeouts *timeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *sourceAggregationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *sourceAggregationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     stateVersion(sourceAggregationStateUpgrades),
+		Description: fmt.Sprintf("Aggregates the %[1]s of a delimited file source from a CSV file, as when seeding a test tenant. The file is uploaded when the resource is created, and again when it is replaced, as when file_hash changes. Destroying the resource leaves the aggregated %[1]s as they are.", r.objects),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "ID of the aggregation task.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"source_id": schema.StringAttribute{
+				Required:      true,
+				Validators:    []validator.String{sailPointIDValidator{}},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the delimited file source.",
+			},
+			"file": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   fmt.Sprintf("Path of the CSV file listing the %s.", r.objects),
+			},
+			"file_hash": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Hash of the content of the file, such as filesha256(file), so the file is aggregated again when its content changes.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the aggregation to complete, failing the apply when it fails. Defaults to true.",
+			},
+			"status": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Completion status of the aggregation task, PENDING when it wasn't waited for.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsResourceSchemaBlock,
+		},
+	}
+}
+
+func (r *sourceAggregationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(sourceAggregationStateUpgrades)
+}
+
+func (r *sourceAggregationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SourceAggregation resource", map[string]any{"objects": r.objects})
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client()
+	r.rateLimit = data.rateLimit
+	r.validateReferences = data.validateReferences
+}
+
+func (r *sourceAggregationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing references other objects in destroy plans.
+	if !r.validateReferences || req.Plan.Raw.IsNull() {
+		return
+	}
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	references := planReferences(ctx, req, map[string]referenceKind{
+		"source_id": sourceReference,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkReferences(ctx, r.client, references, &resp.Diagnostics)
+}
+
+func (r *sourceAggregationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating source aggregation resource", map[string]any{"objects": r.objects})
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan sourceAggregationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ctx is kept to tell the timeout of the creation from a cancelled run.
+	timeout := plan.Timeouts.create(sourceAggregationDefaultTimeout)
+	createCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The SDK closes the file once uploaded.
+	file, err := os.Open(plan.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "Unable to Open Aggregation File", err.Error())
+		return
+	}
+
+	sourceID := plan.SourceID.ValueString()
+	taskID, res, err := r.start(createCtx, r.client, sourceID, file)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, fmt.Sprintf("Unable to Aggregate the %s of Source %s", r.objects, sourceID), err, res)
+		return
+	}
+
+	plan.ID = types.StringValue(taskID)
+	plan.Status = types.StringValue(taskStatusPending)
+
+	if plan.Wait.IsNull() || plan.Wait.ValueBool() {
+		task := fmt.Sprintf("Aggregation task %s of source %s", taskID, sourceID)
+		status, res, err := waitTaskStatus(createCtx, r.client, task, taskID)
+		if err != nil {
+			addTaskPollError(ctx, &resp.Diagnostics, "Source Aggregation", task, timeout, status, err, res)
+			return
+		}
+		plan.Status = types.StringValue(status)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the result of the aggregation, which is only run on create.
+func (r *sourceAggregationResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Info(ctx, "reading source aggregation resource", map[string]any{"objects": r.objects})
+}
+
+// Update only stores the plan, changes of the source or the file replace the
+// resource.
+func (r *sourceAggregationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating source aggregation resource", map[string]any{"objects": r.objects})
+
+	var plan sourceAggregationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state.
+func (r *sourceAggregationResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting source aggregation resource, the aggregated objects are left as they are", map[string]any{"objects": r.objects})
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ list.ListResource              = &sourceAttributeSyncListResource{}
+	_ list.ListResourceWithConfigure = &sourceAttributeSyncListResource{}
+)
+
+func NewSourceAttributeSyncListResource() list.ListResource {
+	return &sourceAttributeSyncListResource{}
+}
+
+// sourceAttributeSyncListResource lists the attribute sync configurations of
+// the sources, those the source attribute sync resource can manage.
+type sourceAttributeSyncListResource struct {
+	data *providerData
+}
+
+type sourceAttributeSyncListResourceModel struct {
+	Filters types.String `tfsdk:"filters"`
+}
+
+func (l *sourceAttributeSyncListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_attribute_sync"
+}
+
+func (l *sourceAttributeSyncListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the attribute sync configurations of the sources. Sources without identity attributes to synchronize are skipped. The API is experimental.",
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter of the sources listed, using the standard syntax described in V3 API Standard Collection Parameters (ex. name sw \"Active Directory\").",
+			},
+		},
+	}
+}
+
+func (l *sourceAttributeSyncListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SourceAttributeSync list resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.data = data
+}
+
+func (l *sourceAttributeSyncListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, "Listing Source Attribute Syncs")
+
+	var (
+		config sourceAttributeSyncListResourceModel
+		diags  diag.Diagnostics
+	)
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	client := l.data.client()
+	if !l.data.experimentalEnabled(client, "sailpoint_source_attribute_sync", &diags) {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Whether a source has attributes to synchronize is only known from its
+	// configuration, so all the sources are read and the limit applies to
+	// those which have some.
+	pagination := paginationModel{MaxResults: types.Int64Value(0)}
+	request := client.V2025.SourcesAPI.ListSources(ctx)
+	if !config.Filters.IsNull() {
+		request = request.Filters(config.Filters.ValueString())
+	}
+	sources, res, err := paginate[api_v2025.Source](request, &pagination)
+	if err != nil {
+		l.data.rateLimit.warnRateLimit(&diags)
+		addAPIError(ctx, &diags, "Unable to List Sources", err, res)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	configs := make([]*api_v2025.AttrSyncSourceConfig, len(sources))
+	responses := make([]*http.Response, len(sources))
+	failed, err := forEachConcurrently(ctx, len(sources), detailFetchWorkers, func(ctx context.Context, i int) error {
+		var err error
+		configs[i], responses[i], err = client.V2025.SourcesAPI.GetSourceAttrSyncConfig(ctx, sources[i].GetId()).Execute()
+		if responses[i] != nil && responses[i].StatusCode == http.StatusNotFound {
+			// The source was deleted since it was listed.
+			return nil
+		}
+		return err
+	})
+	l.data.rateLimit.warnRateLimit(&diags)
+	if err != nil {
+		if failed < 0 {
+			addAPIError(ctx, &diags, "Unable to Read Attribute Sync Configurations", err, nil)
+		} else {
+			addAPIError(ctx, &diags, fmt.Sprintf("Unable to Read the Attribute Sync Configuration of Source %s", sources[failed].GetId()), err, responses[failed])
+		}
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var listed int64
+		for i, source := range sources {
+			if req.Limit > 0 && listed == req.Limit {
+				return
+			}
+			if configs[i] == nil || len(configs[i].Attributes) == 0 {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = source.GetName()
+			if listed == 0 {
+				// Rate limit warnings
+				result.Diagnostics.Append(diags...)
+			}
+			setIDIdentity(ctx, result.Identity, types.StringValue(source.GetId()), &result.Diagnostics)
+
+			if req.IncludeResource {
+				state := sourceAttributeSyncResourceModel{
+					ID:       types.StringValue(source.GetId()),
+					SourceID: types.StringValue(source.GetId()),
+				}
+				result.Diagnostics.Append(state.setConfig(ctx, configs[i])...)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+				}
+			}
+
+			listed++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ resource.Resource                   = &sourceAttributeSyncResource{}
+	_ resource.ResourceWithConfigure      = &sourceAttributeSyncResource{}
+	_ resource.ResourceWithValidateConfig = &sourceAttributeSyncResource{}
+	_ resource.ResourceWithImportState    = &sourceAttributeSyncResource{}
+	_ resource.ResourceWithIdentity       = &sourceAttributeSyncResource{}
+	_ resource.ResourceWithUpgradeState   = &sourceAttributeSyncResource{}
+	_ resource.ResourceWithModifyPlan     = &sourceAttributeSyncResource{}
+)
+
+var sourceAttributeSyncStateUpgrades []stateUpgrade
+
+const sourceAttributeSyncDefaultTimeout = 10 * time.Minute
+
+func NewSourceAttributeSyncResource() resource.Resource {
+	return &sourceAttributeSyncResource{}
+}
+
+type sourceAttributeSyncResource struct {
+	data *providerData
+}
+
+type sourceAttributeSyncResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	SourceID          types.String   `tfsdk:"source_id"`
+	EnabledAttributes types.Set      `tfsdk:"enabled_attributes"`
+	Targets           types.Map      `tfsdk:"targets"`
+	Timeouts          *timeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *sourceAttributeSyncResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_attribute_sync"
+}
+
+func (r *sourceAttributeSyncResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     stateVersion(sourceAttributeSyncStateUpgrades),
+		Description: "Selects the identity attributes synchronized to the accounts of a source, the attribute sync configuration of the source. The identity attributes which aren't listed are not synchronized, and destroying the resource disables the synchronization of every attribute. The API is experimental.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the source.",
+			},
+			"source_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{sailPointIDValidator{}},
+				Description:   "ID of the source.",
+			},
+			"enabled_attributes": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Names of the identity attributes synchronized to the accounts of the source (ex. email). They must be among the attributes of the sync configuration of the source, see targets.",
+			},
+			"targets": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Account attribute each identity attribute of the sync configuration is synchronized to, by identity attribute name, whether it is enabled or not.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsResourceSchemaBlock,
+		},
+	}
+}
+
+func (r *sourceAttributeSyncResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(sourceAttributeSyncStateUpgrades)
+}
+
+func (r *sourceAttributeSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SourceAttributeSync resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.data = data
+}
+
+func (r *sourceAttributeSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing references other objects in destroy plans.
+	if r.data == nil || !r.data.validateReferences || req.Plan.Raw.IsNull() {
+		return
+	}
+	defer r.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	references := planReferences(ctx, req, map[string]referenceKind{
+		"source_id": sourceReference,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkReferences(ctx, r.data.client(), references, &resp.Diagnostics)
+}
+
+// ValidateConfig rejects the resource at plan time when the provider doesn't
+// enable experimental APIs.
+func (r *sourceAttributeSyncResource) ValidateConfig(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	r.data.requireExperimental("sailpoint_source_attribute_sync", &resp.Diagnostics)
+}
+
+func (r *sourceAttributeSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating source attribute sync resource")
+	defer r.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan sourceAttributeSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.create(sourceAttributeSyncDefaultTimeout))
+	defer cancel()
+
+	plan.ID = plan.SourceID
+	// The source may have been created in the same apply, and not be visible
+	// yet.
+	resp.Diagnostics.Append(r.apply(ctx, &plan, r.data.readAfterCreateTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIDIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+func (r *sourceAttributeSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading source attribute sync resource")
+	defer r.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var state sourceAttributeSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.data.client()
+	if !r.data.experimentalEnabled(client, "sailpoint_source_attribute_sync", &resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, state.Timeouts.read(sourceAttributeSyncDefaultTimeout))
+	defer cancel()
+
+	// The ID is the ID of the source, the only attribute an import sets.
+	if state.SourceID.IsNull() {
+		state.SourceID = state.ID
+	}
+
+	config, res, err := client.V2025.SourcesAPI.GetSourceAttrSyncConfig(ctx, state.SourceID.ValueString()).Execute()
+	if res != nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Attribute Sync Configuration", err, res)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setConfig(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	setIDIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
+}
+
+func (r *sourceAttributeSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating source attribute sync resource")
+	defer r.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan sourceAttributeSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, plan.Timeouts.update(sourceAttributeSyncDefaultTimeout))
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, 0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIDIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+func (r *sourceAttributeSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting source attribute sync resource")
+	defer r.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var state sourceAttributeSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, state.Timeouts.delete(sourceAttributeSyncDefaultTimeout))
+	defer cancel()
+
+	// A deleted source has no attribute to synchronize anymore, so a missing
+	// configuration is left as it is.
+	_, diags := r.sync(ctx, state.SourceID.ValueString(), nil, 0)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *sourceAttributeSyncResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
+// ImportState imports the attribute sync configuration of a source by the ID
+// of the source, by its name prefixed with name:, or by identity.
+func (r *sourceAttributeSyncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByIDOrName(ctx, req, resp, "source", func(ctx context.Context, name string) ([]string, *http.Response, error) {
+		return sourceIDsNamed(ctx, r.data.client(), name)
+	})
+}
+
+// apply synchronizes the enabled attributes of m, and sets its targets. See
+// sync for readTimeout.
+func (r *sourceAttributeSyncResource) apply(ctx context.Context, m *sourceAttributeSyncResourceModel, readTimeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var enabled []string
+	diags.Append(m.EnabledAttributes.ElementsAs(ctx, &enabled, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	config, syncDiags := r.sync(ctx, m.SourceID.ValueString(), enabled, readTimeout)
+	diags.Append(syncDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if config == nil {
+		diags.AddAttributeError(path.Root("source_id"), "Source Not Found", fmt.Sprintf("Source %s doesn't exist.", m.SourceID.ValueString()))
+		return diags
+	}
+
+	diags.Append(m.setConfig(ctx, config)...)
+	return diags
+}
+
+// sync enables the synchronization of the enabled attributes, and disables it
+// for the other attributes of the configuration of the source. It returns the
+// updated configuration, or nil when the source doesn't exist. The
+// configuration of a missing source is read again until readTimeout elapsed,
+// see readAfterCreate.
+func (r *sourceAttributeSyncResource) sync(ctx context.Context, sourceID string, enabled []string, readTimeout time.Duration) (*api_v2025.AttrSyncSourceConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client := r.data.client()
+	if !r.data.experimentalEnabled(client, "sailpoint_source_attribute_sync", &diags) {
+		return nil, diags
+	}
+
+	config, res, err := readAfterCreate(ctx, readTimeout, func(ctx context.Context) (*api_v2025.AttrSyncSourceConfig, *http.Response, error) {
+		return client.V2025.SourcesAPI.GetSourceAttrSyncConfig(ctx, sourceID).Execute()
+	})
+	if res != nil && res.StatusCode == http.StatusNotFound {
+		return nil, diags
+	}
+	if err != nil {
+		addAPIError(ctx, &diags, "Unable to Read Attribute Sync Configuration", err, res)
+		return nil, diags
+	}
+
+	if unknown := enableSyncedAttributes(config, enabled); len(unknown) > 0 {
+		diags.AddAttributeError(
+			path.Root("enabled_attributes"),
+			"Unknown Synchronized Attributes",
+			fmt.Sprintf("The attribute sync configuration of source %s has no identity attribute %s, it has: %s.", sourceID, strings.Join(unknown, ", "), strings.Join(syncedAttributeNames(config), ", ")),
+		)
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "Updating attribute sync configuration", map[string]any{"source_id": sourceID, "enabled": enabled})
+	config, res, err = client.V2025.SourcesAPI.PutSourceAttrSyncConfig(ctx, sourceID).AttrSyncSourceConfig(*config).Execute()
+	if err != nil {
+		addAPIError(ctx, &diags, "Unable to Update Attribute Sync Configuration", err, res)
+		return nil, diags
+	}
+	return config, diags
+}
+
+// setConfig sets the enabled attributes and the targets of m from the
+// configuration of the source.
+func (m *sourceAttributeSyncResourceModel) setConfig(ctx context.Context, config *api_v2025.AttrSyncSourceConfig) diag.Diagnostics {
+	enabled := make([]string, 0, len(config.Attributes))
+	targets := make(map[string]string, len(config.Attributes))
+	for _, attribute := range config.Attributes {
+		if attribute.Enabled {
+			enabled = append(enabled, attribute.Name)
+		}
+		targets[attribute.Name] = attribute.Target
+	}
+
+	var diags, d diag.Diagnostics
+	m.EnabledAttributes, d = types.SetValueFrom(ctx, types.StringType, enabled)
+	diags.Append(d...)
+	m.Targets, d = types.MapValueFrom(ctx, types.StringType, targets)
+	diags.Append(d...)
+	return diags
+}
+
+// enableSyncedAttributes enables the attributes of config named in enabled
+// and disables the others. It returns the names of enabled which aren't in
+// config, sorted.
+func enableSyncedAttributes(config *api_v2025.AttrSyncSourceConfig, enabled []string) []string {
+	remaining := make(map[string]bool, len(enabled))
+	for _, name := range enabled {
+		remaining[name] = true
+	}
+	for i := range config.Attributes {
+		name := config.Attributes[i].Name
+		config.Attributes[i].Enabled = remaining[name]
+		delete(remaining, name)
+	}
+
+	unknown := make([]string, 0, len(remaining))
+	for name := range remaining {
+		unknown = append(unknown, name)
+	}
+	slices.Sort(unknown)
+	return unknown
+}
+
+// sourceIDsNamed returns the IDs of the sources with the given name.
+func sourceIDsNamed(ctx context.Context, client *sailpoint.APIClient, name string) ([]string, *http.Response, error) {
+	sources, res, err := client.V2025.SourcesAPI.ListSources(ctx).Filters("name eq " + quoteFilterString(name)).Execute()
+	ids := make([]string, 0, len(sources))
+	for _, source := range sources {
+		ids = append(ids, source.GetId())
+	}
+	return ids, res, err
+}
+
+func syncedAttributeNames(config *api_v2025.AttrSyncSourceConfig) []string {
+	names := make([]string, 0, len(config.Attributes))
+	for _, attribute := range config.Attributes {
+		names = append(names, attribute.Name)
+	}
+	return names
+}
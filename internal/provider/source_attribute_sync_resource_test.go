@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestEnableSyncedAttributes(t *testing.T) {
+	config := &api_v2025.AttrSyncSourceConfig{Attributes: []api_v2025.AttrSyncSourceAttributeConfig{
+		{Name: "email", Target: "mail", Enabled: false},
+		{Name: "firstname", Target: "givenName", Enabled: true},
+	}}
+
+	unknown := enableSyncedAttributes(config, []string{"email", "phone", "department"})
+	if !slices.Equal(unknown, []string{"department", "phone"}) {
+		t.Errorf("unexpected unknown attributes %v", unknown)
+	}
+	if !config.Attributes[0].Enabled || config.Attributes[1].Enabled {
+		t.Errorf("unexpected attributes %+v", config.Attributes)
+	}
+}
+
+func TestSourceAttributeSyncListResourceList(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeSailPoint(t)
+	fake.respond(http.MethodGet, "/v2025/sources", http.StatusOK, `[
+		{"id": "s1", "name": "AD", "owner": {"type": "IDENTITY", "id": "o1"}, "connector": "active-directory"},
+		{"id": "s2", "name": "HR", "owner": {"type": "IDENTITY", "id": "o1"}, "connector": "workday"},
+		{"id": "s3", "name": "Deleted", "owner": {"type": "IDENTITY", "id": "o1"}, "connector": "workday"}
+	]`)
+	fake.respond(http.MethodGet, "/v2025/sources/s1/attribute-sync-config", http.StatusOK, `{"source": {"type": "SOURCE", "id": "s1", "name": "AD"}, "attributes": [
+		{"name": "email", "displayName": "Email", "enabled": true, "target": "mail"},
+		{"name": "firstname", "displayName": "First Name", "enabled": false, "target": "givenName"}
+	]}`)
+	fake.respond(http.MethodGet, "/v2025/sources/s2/attribute-sync-config", http.StatusOK, `{"source": {"type": "SOURCE", "id": "s2", "name": "HR"}, "attributes": []}`)
+	fake.respond(http.MethodGet, "/v2025/sources/s3/attribute-sync-config", http.StatusNotFound, `{}`)
+
+	data := fake.providerData()
+	data.client().V2025.GetConfig().Experimental = true
+	l := NewSourceAttributeSyncListResource().(*sourceAttributeSyncListResource)
+	var configureResp resource.ConfigureResponse
+	l.Configure(ctx, resource.ConfigureRequest{ProviderData: data}, &configureResp)
+
+	var schemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	var resourceSchemaResp resource.SchemaResponse
+	NewSourceAttributeSyncResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{"filters": tftypes.NewValue(tftypes.String, nil)}),
+		},
+		IncludeResource:        true,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: idIdentitySchema,
+	}
+	var stream list.ListResultsStream
+	l.List(ctx, req, &stream)
+
+	var names []string
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatal(result.Diagnostics)
+		}
+		var state sourceAttributeSyncResourceModel
+		result.Diagnostics.Append(result.Resource.Get(ctx, &state)...)
+		var enabled []string
+		result.Diagnostics.Append(state.EnabledAttributes.ElementsAs(ctx, &enabled, false)...)
+		if result.Diagnostics.HasError() {
+			t.Fatal(result.Diagnostics)
+		}
+		if state.SourceID.ValueString() != "s1" || !slices.Equal(enabled, []string{"email"}) {
+			t.Errorf("unexpected source %s with enabled attributes %v", state.SourceID, enabled)
+		}
+		names = append(names, result.DisplayName)
+	}
+	if !slices.Equal(names, []string{"AD"}) {
+		t.Errorf("expected only AD, got %v", names)
+	}
+}
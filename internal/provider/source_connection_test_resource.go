@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+// sourceConnectionTestStatusSuccess is the status of a successful test of
+// the connection of a source.
+const sourceConnectionTestStatusSuccess = "SUCCESS"
+
+var (
+	_ resource.Resource                 = &sourceConnectionTestResource{}
+	_ resource.ResourceWithConfigure    = &sourceConnectionTestResource{}
+	_ resource.ResourceWithUpgradeState = &sourceConnectionTestResource{}
+	_ resource.ResourceWithModifyPlan   = &sourceConnectionTestResource{}
+)
+
+var sourceConnectionTestStateUpgrades []stateUpgrade
+
+func NewSourceConnectionTestResource() resource.Resource {
+	return &sourceConnectionTestResource{}
+}
+
+type sourceConnectionTestResource struct {
+	client             *sailpoint.APIClient
+	rateLimit          *rateLimitUsage
+	validateReferences bool
+}
+
+type sourceConnectionTestResourceModel struct {
+	ID            types.String        `tfsdk:"id"`
+	SourceID      types.String        `tfsdk:"source_id"`
+	Triggers      types.Map           `tfsdk:"triggers"`
+	Status        types.String        `tfsdk:"status"`
+	ElapsedMillis types.Int32         `tfsdk:"elapsed_millis"`
+	Details       normalizedJSONValue `tfsdk:"details"`
+}
+
+func (r *sourceConnectionTestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_connection_test"
+}
+
+func (r *sourceConnectionTestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     stateVersion(sourceConnectionTestStateUpgrades),
+		Description: "Tests the connection of a source when created, and fails the apply when the connector can't connect, so a misconfigured source is caught by the apply which creates it. The result of the test is kept in the state, the test runs again when the resource is replaced, as when triggers change. Destroying the resource leaves the source as it is.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the tested source.",
+			},
+			"source_id": schema.StringAttribute{
+				Required:      true,
+				Validators:    []validator.String{sailPointIDValidator{}},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the source whose connection is tested.",
+			},
+			"triggers": schema.MapAttribute{
+				Optional:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+				Description:   "Arbitrary values which run the test again when they change, such as the version of the connector configuration.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the test, SUCCESS since failed tests fail the apply.",
+			},
+			"elapsed_millis": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Duration of the test, in milliseconds.",
+			},
+			"details": schema.StringAttribute{
+				CustomType:  normalizedJSONType{},
+				Computed:    true,
+				Description: "Results of the test as a JSON document, whose schema depends on the connector.",
+			},
+		},
+	}
+}
+
+func (r *sourceConnectionTestResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(sourceConnectionTestStateUpgrades)
+}
+
+func (r *sourceConnectionTestResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SourceConnectionTest resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client()
+	r.rateLimit = data.rateLimit
+	r.validateReferences = data.validateReferences
+}
+
+func (r *sourceConnectionTestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing references other objects in destroy plans.
+	if !r.validateReferences || req.Plan.Raw.IsNull() {
+		return
+	}
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	references := planReferences(ctx, req, map[string]referenceKind{
+		"source_id": sourceReference,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkReferences(ctx, r.client, references, &resp.Diagnostics)
+}
+
+func (r *sourceConnectionTestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating source connection test resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan sourceConnectionTestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := plan.SourceID.ValueString()
+	result, res, err := r.client.V2025.SourcesAPI.TestSourceConnection(ctx, sourceID).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Test Source Connection", err, res)
+		return
+	}
+
+	details, err := serializeSourceConnectionTest(&plan, result)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Source Connection Test Details", err.Error())
+		return
+	}
+	if plan.Status.ValueString() != sourceConnectionTestStatusSuccess {
+		resp.Diagnostics.AddError(
+			"Source Connection Test Failed",
+			fmt.Sprintf("The connection test of source %s finished with status %s: %s", sourceID, plan.Status.ValueString(), details),
+		)
+		return
+	}
+
+	plan.ID = plan.SourceID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the result of the test, which is only run on create.
+func (r *sourceConnectionTestResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	tflog.Info(ctx, "reading source connection test resource")
+}
+
+// Update only stores the plan, changes of the configurable attributes
+// replace the resource.
+func (r *sourceConnectionTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating source connection test resource")
+
+	var plan sourceConnectionTestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state.
+func (r *sourceConnectionTestResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting source connection test resource, the source is left as it is")
+}
+
+// serializeSourceConnectionTest sets the result attributes of state from the
+// response of the test, and returns its details as a JSON document.
+func serializeSourceConnectionTest(state *sourceConnectionTestResourceModel, result *api_v2025.StatusResponse) (string, error) {
+	state.Status = types.StringPointerValue(result.Status)
+	state.ElapsedMillis = types.Int32PointerValue(result.ElapsedMillis)
+	state.Details = normalizedJSONNull()
+	if result.Details == nil {
+		return "", nil
+	}
+
+	details, err := json.Marshal(result.Details)
+	if err != nil {
+		return "", err
+	}
+	state.Details = normalizedJSONStringValue(string(details))
+	return string(details), nil
+}
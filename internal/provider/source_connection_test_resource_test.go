@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestSerializeSourceConnectionTest(t *testing.T) {
+	var result api_v2025.StatusResponse
+	body := `{"id": "s1", "name": "HR", "status": "FAILURE", "elapsedMillis": 1200, "details": {"error": "Connection refused"}}`
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	var state sourceConnectionTestResourceModel
+	details, err := serializeSourceConnectionTest(&state, &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Status.ValueString() != "FAILURE" || state.ElapsedMillis.ValueInt32() != 1200 {
+		t.Errorf("unexpected result %s in %s", state.Status, state.ElapsedMillis)
+	}
+	if expected := `{"error":"Connection refused"}`; details != expected || state.Details.ValueString() != expected {
+		t.Errorf("expected details %s, got %s", expected, details)
+	}
+
+	if _, err := serializeSourceConnectionTest(&state, &api_v2025.StatusResponse{}); err != nil || !state.Details.IsNull() {
+		t.Errorf("expected null details, got %s", state.Details)
+	}
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const (
+	sourcePeekObjectsDefaultObjectType = "account"
+	sourcePeekObjectsDefaultMaxCount   = 25
+)
+
+var (
+	_ datasource.DataSource              = &sourcePeekObjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &sourcePeekObjectsDataSource{}
+)
+
+func NewSourcePeekObjectsDataSource() datasource.DataSource {
+	return &sourcePeekObjectsDataSource{}
+}
+
+type sourcePeekObjectsDataSource struct {
+	data *providerData
+}
+
+type sourcePeekObjectsDataSourceModel struct {
+	APIVersion    types.String            `tfsdk:"api_version"`
+	SourceID      types.String            `tfsdk:"source_id"`
+	ObjectType    types.String            `tfsdk:"object_type"`
+	MaxCount      types.Int64             `tfsdk:"max_count"`
+	ObjectCount   types.Int32             `tfsdk:"object_count"`
+	ElapsedMillis types.Int32             `tfsdk:"elapsed_millis"`
+	Objects       []sourcePeekObjectModel `tfsdk:"objects"`
+}
+
+type sourcePeekObjectModel struct {
+	Identity   types.String        `tfsdk:"identity"`
+	UUID       types.String        `tfsdk:"uuid"`
+	Name       types.String        `tfsdk:"name"`
+	ObjectType types.String        `tfsdk:"object_type"`
+	Attributes normalizedJSONValue `tfsdk:"attributes"`
+}
+
+func (d *sourcePeekObjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_peek_objects"
+}
+
+func (d *sourcePeekObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sample of the objects the connector of a source reads from the managed system, such as accounts or groups, without aggregating them. Pipelines use it to check the attribute mappings of a connector.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"source_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the source.",
+			},
+			"object_type": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Type of the objects read, as named in the schemas of the source (ex. account, group). Defaults to %s.", sourcePeekObjectsDefaultObjectType),
+			},
+			"max_count": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64RangeValidator{min: 1}},
+				Description: fmt.Sprintf("Maximum number of objects read. Defaults to %d.", sourcePeekObjectsDefaultMaxCount),
+			},
+			"object_count": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Number of objects read.",
+			},
+			"elapsed_millis": schema.Int32Attribute{
+				Computed:    true,
+				Description: "Duration of the read, in milliseconds.",
+			},
+			"objects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Objects read.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identity": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identity of the object in the managed system.",
+						},
+						"uuid": schema.StringAttribute{
+							Computed:    true,
+							Description: "Universal identifier of the object in the managed system.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the object.",
+						},
+						"object_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the object.",
+						},
+						"attributes": schema.StringAttribute{
+							CustomType:  normalizedJSONType{},
+							Computed:    true,
+							Description: "Attributes of the object as a JSON object.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sourcePeekObjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SourcePeekObjects data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *sourcePeekObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Source Peek Objects")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state sourcePeekObjectsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := api_v2025.NewResourceObjectsRequest()
+	if !state.ObjectType.IsNull() {
+		request.SetObjectType(state.ObjectType.ValueString())
+	}
+	if !state.MaxCount.IsNull() {
+		request.SetMaxCount(int32(state.MaxCount.ValueInt64()))
+	}
+
+	tflog.Debug(ctx, "Peeking source objects", map[string]any{"source_id": state.SourceID.ValueString(), "object_type": request.GetObjectType(), "max_count": request.GetMaxCount()})
+
+	objects, res, err := client.V2025.SourcesAPI.SearchResourceObjects(ctx, state.SourceID.ValueString()).ResourceObjectsRequest(*request).Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Peek Source Objects", err, res)
+		return
+	}
+
+	if err := serializeSourcePeekObjects(&state, objects); err != nil {
+		resp.Diagnostics.AddError("Unable to Read Source Object Attributes", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// serializeSourcePeekObjects sets the computed attributes of state from the
+// objects read by the connector.
+func serializeSourcePeekObjects(state *sourcePeekObjectsDataSourceModel, objects *api_v2025.ResourceObjectsResponse) error {
+	state.ObjectCount = types.Int32PointerValue(objects.ObjectCount)
+	state.ElapsedMillis = types.Int32PointerValue(objects.ElapsedMillis)
+	state.Objects = make([]sourcePeekObjectModel, 0, len(objects.ResourceObjects))
+
+	for _, object := range objects.ResourceObjects {
+		attributes := object.GetAttributes()
+		if attributes == nil {
+			attributes = map[string]interface{}{}
+		}
+		document, err := json.Marshal(attributes)
+		if err != nil {
+			return fmt.Errorf("attributes of %s: %w", object.GetIdentity(), err)
+		}
+		state.Objects = append(state.Objects, sourcePeekObjectModel{
+			Identity:   types.StringPointerValue(object.Identity),
+			UUID:       types.StringPointerValue(object.Uuid),
+			Name:       types.StringPointerValue(object.Name),
+			ObjectType: types.StringPointerValue(object.ObjectType),
+			Attributes: normalizedJSONStringValue(string(document)),
+		})
+	}
+	return nil
+}
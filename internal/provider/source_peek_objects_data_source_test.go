@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestSerializeSourcePeekObjects(t *testing.T) {
+	var objects api_v2025.ResourceObjectsResponse
+	body := `{"objectCount": 2, "elapsedMillis": 350, "resourceObjects": [
+		{"identity": "jdoe", "uuid": "u1", "name": "John Doe", "objectType": "account", "attributes": {"mail": "jdoe@example.com", "groups": ["admins"]}},
+		{"identity": "asmith", "objectType": "account"}
+	]}`
+	if err := json.Unmarshal([]byte(body), &objects); err != nil {
+		t.Fatal(err)
+	}
+
+	var state sourcePeekObjectsDataSourceModel
+	if err := serializeSourcePeekObjects(&state, &objects); err != nil {
+		t.Fatal(err)
+	}
+	if state.ObjectCount.ValueInt32() != 2 || state.ElapsedMillis.ValueInt32() != 350 || len(state.Objects) != 2 {
+		t.Fatalf("unexpected objects %+v", state)
+	}
+	if expected := `{"groups":["admins"],"mail":"jdoe@example.com"}`; state.Objects[0].Attributes.ValueString() != expected {
+		t.Errorf("expected attributes %s, got %s", expected, state.Objects[0].Attributes)
+	}
+	if state.Objects[1].Attributes.ValueString() != "{}" || !state.Objects[1].UUID.IsNull() {
+		t.Errorf("unexpected object %+v", state.Objects[1])
+	}
+}
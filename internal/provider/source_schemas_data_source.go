@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &sourceSchemasDataSource{}
+	_ datasource.DataSourceWithConfigure = &sourceSchemasDataSource{}
+)
+
+func NewSourceSchemasDataSource() datasource.DataSource {
+	return &sourceSchemasDataSource{}
+}
+
+type sourceSchemasDataSource struct {
+	data *providerData
+}
+
+type sourceSchemasDataSourceModel struct {
+	APIVersion types.String        `tfsdk:"api_version"`
+	SourceID   types.String        `tfsdk:"source_id"`
+	Names      []types.String      `tfsdk:"names"`
+	GroupsOnly types.Bool          `tfsdk:"groups_only"`
+	Schemas    []sourceSchemaModel `tfsdk:"schemas"`
+}
+
+type sourceSchemaModel struct {
+	ID                 types.String                 `tfsdk:"id"`
+	Name               types.String                 `tfsdk:"name"`
+	NativeObjectType   types.String                 `tfsdk:"native_object_type"`
+	IdentityAttribute  types.String                 `tfsdk:"identity_attribute"`
+	DisplayAttribute   types.String                 `tfsdk:"display_attribute"`
+	HierarchyAttribute types.String                 `tfsdk:"hierarchy_attribute"`
+	IncludePermissions types.Bool                   `tfsdk:"include_permissions"`
+	Features           []types.String               `tfsdk:"features"`
+	Attributes         []sourceSchemaAttributeModel `tfsdk:"attributes"`
+}
+
+type sourceSchemaAttributeModel struct {
+	Name          types.String `tfsdk:"name"`
+	NativeName    types.String `tfsdk:"native_name"`
+	Type          types.String `tfsdk:"type"`
+	SchemaName    types.String `tfsdk:"schema_name"`
+	Description   types.String `tfsdk:"description"`
+	IsMulti       types.Bool   `tfsdk:"is_multi"`
+	IsEntitlement types.Bool   `tfsdk:"is_entitlement"`
+	IsGroup       types.Bool   `tfsdk:"is_group"`
+}
+
+func (d *sourceSchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_schemas"
+}
+
+func (d *sourceSchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Schemas of a source and their attributes, as discovered from the connector, to generate source schema configurations from real connector output. The public API doesn't run the discovery itself: the attributes are the ones of the last discovery, run from the source configuration in the UI, or of the last schema update.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersions),
+			"source_id": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{sailPointIDValidator{}},
+				Description: "ID of the source.",
+			},
+			"names": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Names of the schemas read (ex. account, group). Every schema is read when omitted.",
+			},
+			"groups_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to only read the group schemas, names is then ignored. Defaults to false.",
+			},
+			"schemas": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Schemas of the source.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the schema.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the schema, such as account or group.",
+						},
+						"native_object_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the object type in the managed system.",
+						},
+						"identity_attribute": schema.StringAttribute{
+							Computed:    true,
+							Description: "Attribute identifying the objects.",
+						},
+						"display_attribute": schema.StringAttribute{
+							Computed:    true,
+							Description: "Attribute naming the objects.",
+						},
+						"hierarchy_attribute": schema.StringAttribute{
+							Computed:    true,
+							Description: "Attribute referencing the parent of the objects, for hierarchical groups.",
+						},
+						"include_permissions": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the permissions of the objects are aggregated.",
+						},
+						"features": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Features of the connector supported by the schema.",
+						},
+						"attributes": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Attributes of the schema.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the attribute.",
+									},
+									"native_name": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the attribute in the managed system.",
+									},
+									"type": schema.StringAttribute{
+										Computed:    true,
+										Description: "Type of the attribute, such as STRING or BOOLEAN.",
+									},
+									"schema_name": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the schema of the objects the attribute references, for group attributes.",
+									},
+									"description": schema.StringAttribute{
+										Computed:    true,
+										Description: "Description of the attribute.",
+									},
+									"is_multi": schema.BoolAttribute{
+										Computed:    true,
+										Description: "Whether the attribute has multiple values.",
+									},
+									"is_entitlement": schema.BoolAttribute{
+										Computed:    true,
+										Description: "Whether the values of the attribute are entitlements.",
+									},
+									"is_group": schema.BoolAttribute{
+										Computed:    true,
+										Description: "Whether the values of the attribute are groups.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sourceSchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SourceSchemas data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *sourceSchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Source Schemas")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state sourceSchemasDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := client.V2025.SourcesAPI.GetSourceSchemas(ctx, state.SourceID.ValueString())
+	if state.GroupsOnly.ValueBool() {
+		request = request.IncludeTypes("group")
+	}
+	if len(state.Names) > 0 {
+		names := make([]string, 0, len(state.Names))
+		for _, name := range state.Names {
+			names = append(names, name.ValueString())
+		}
+		request = request.IncludeNames(strings.Join(names, ","))
+	}
+
+	schemas, res, err := request.Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Source Schemas", err, res)
+		return
+	}
+
+	state.Schemas = serializeSourceSchemas(schemas)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// serializeSourceSchemas returns the models of the schemas of a source, the
+// schema referenced by a group attribute being named by schema_name.
+func serializeSourceSchemas(schemas []api_v2025.Schema) []sourceSchemaModel {
+	models := make([]sourceSchemaModel, 0, len(schemas))
+	for _, sourceSchema := range schemas {
+		model := sourceSchemaModel{
+			ID:                 types.StringPointerValue(sourceSchema.Id),
+			Name:               types.StringPointerValue(sourceSchema.Name),
+			NativeObjectType:   types.StringPointerValue(sourceSchema.NativeObjectType),
+			IdentityAttribute:  types.StringPointerValue(sourceSchema.IdentityAttribute),
+			DisplayAttribute:   types.StringPointerValue(sourceSchema.DisplayAttribute),
+			HierarchyAttribute: types.StringPointerValue(sourceSchema.HierarchyAttribute.Get()),
+			IncludePermissions: types.BoolPointerValue(sourceSchema.IncludePermissions),
+			Features:           make([]types.String, 0, len(sourceSchema.Features)),
+			Attributes:         make([]sourceSchemaAttributeModel, 0, len(sourceSchema.Attributes)),
+		}
+		for _, feature := range sourceSchema.Features {
+			model.Features = append(model.Features, types.StringValue(feature))
+		}
+		for _, attribute := range sourceSchema.Attributes {
+			attributeModel := sourceSchemaAttributeModel{
+				Name:          types.StringPointerValue(attribute.Name),
+				NativeName:    types.StringPointerValue(attribute.NativeName.Get()),
+				Type:          types.StringNull(),
+				SchemaName:    types.StringNull(),
+				Description:   types.StringPointerValue(attribute.Description),
+				IsMulti:       types.BoolPointerValue(attribute.IsMulti),
+				IsEntitlement: types.BoolPointerValue(attribute.IsEntitlement),
+				IsGroup:       types.BoolPointerValue(attribute.IsGroup),
+			}
+			if attribute.Type != nil {
+				attributeModel.Type = types.StringValue(string(*attribute.Type))
+			}
+			if reference := attribute.Schema.Get(); reference != nil {
+				attributeModel.SchemaName = types.StringPointerValue(reference.Name)
+			}
+			model.Attributes = append(model.Attributes, attributeModel)
+		}
+		models = append(models, model)
+	}
+	return models
+}
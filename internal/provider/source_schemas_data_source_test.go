@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestSerializeSourceSchemas(t *testing.T) {
+	var schemas []api_v2025.Schema
+	body := `[{"id": "s1", "name": "account", "nativeObjectType": "User", "identityAttribute": "sAMAccountName", "displayAttribute": "cn", "features": ["PROVISIONING"], "attributes": [
+		{"name": "memberOf", "type": "STRING", "isMulti": true, "isEntitlement": true, "isGroup": true, "schema": {"type": "CONNECTOR_SCHEMA", "id": "s2", "name": "group"}},
+		{"name": "mail", "nativeName": "email"}
+	]}]`
+	if err := json.Unmarshal([]byte(body), &schemas); err != nil {
+		t.Fatal(err)
+	}
+
+	models := serializeSourceSchemas(schemas)
+	if len(models) != 1 || len(models[0].Attributes) != 2 || len(models[0].Features) != 1 {
+		t.Fatalf("unexpected schemas %+v", models)
+	}
+	if !models[0].HierarchyAttribute.IsNull() || models[0].IdentityAttribute.ValueString() != "sAMAccountName" {
+		t.Errorf("unexpected schema %+v", models[0])
+	}
+	group := models[0].Attributes[0]
+	if group.SchemaName.ValueString() != "group" || group.Type.ValueString() != "STRING" || !group.IsGroup.ValueBool() {
+		t.Errorf("unexpected group attribute %+v", group)
+	}
+	mail := models[0].Attributes[1]
+	if mail.NativeName.ValueString() != "email" || !mail.Type.IsNull() || !mail.SchemaName.IsNull() {
+		t.Errorf("unexpected attribute %+v", mail)
+	}
+}
+
+func TestSourceSchemasDataSourceRead(t *testing.T) {
+	fake := newFakeSailPoint(t)
+	fake.replay("source_schemas")
+	data := fake.providerData()
+
+	state, diags := readTestDataSource(t, NewSourceSchemasDataSource(), data, map[string]tftypes.Value{
+		"source_id": tftypes.NewValue(tftypes.String, "2c9180835d2e5168015d32f890ca1581"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	var model sourceSchemasDataSourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(model.Schemas) != 1 || len(model.Schemas[0].Attributes) != 2 {
+		t.Fatalf("unexpected schemas %+v", model.Schemas)
+	}
+	if model.Schemas[0].Attributes[1].SchemaName.ValueString() != "group" {
+		t.Errorf("unexpected attribute %+v", model.Schemas[0].Attributes[1])
+	}
+
+	_, diags = readTestDataSource(t, NewSourceSchemasDataSource(), data, map[string]tftypes.Value{
+		"source_id": tftypes.NewValue(tftypes.String, "2c9180835d2e5168015d32f890ca1582"),
+	})
+	if !diags.HasError() || diags[0].Summary() != "Unable to Read Source Schemas" {
+		t.Errorf("expected the read to fail, got %v", diags)
+	}
+	if requested := fake.requested(); len(requested) != 2 {
+		t.Errorf("expected 2 requests, got %v", requested)
+	}
+}
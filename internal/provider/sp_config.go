@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const (
+	spConfigJobStatusComplete  = "COMPLETE"
+	spConfigJobStatusFailed    = "FAILED"
+	spConfigJobStatusCancelled = "CANCELLED"
+)
+
+var (
+	spConfigObjectOptionsAttrTypes = map[string]attr.Type{
+		"included_ids":   types.ListType{ElemType: types.StringType},
+		"included_names": types.ListType{ElemType: types.StringType},
+	}
+	spConfigObjectTypeDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"object_type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Object type, such as SOURCE or ROLE.",
+		},
+		"reference_extractors": dataSchema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "JSON paths within an exported object of this type representing references that must be resolved",
+		},
+		"signature_required": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether objects of this type are JWS signed and cannot be modified before import",
+		},
+		"always_resolve_by_id": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether references to objects of this type are always resolved by ID, never by name.",
+		},
+		"legacy_object": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the object type comes from the legacy API.",
+		},
+		"one_per_tenant": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether a tenant has a single object of this type.",
+		},
+		"exportable": dataSchema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether objects of this type can be exported or are just reference objects",
+		},
+	}
+)
+
+type spConfigObjectOptionsModel struct {
+	IncludedIDs   types.List `tfsdk:"included_ids"`
+	IncludedNames types.List `tfsdk:"included_names"`
+}
+
+type spConfigExportDataSourceModel struct {
+	APIVersion    types.String        `tfsdk:"api_version"`
+	IncludeTypes  types.List          `tfsdk:"include_types"`
+	ExcludeTypes  types.List          `tfsdk:"exclude_types"`
+	ObjectOptions types.Map           `tfsdk:"object_options"`
+	Description   types.String        `tfsdk:"description"`
+	Timeout       types.String        `tfsdk:"timeout"`
+	JobID         types.String        `tfsdk:"job_id"`
+	Status        types.String        `tfsdk:"status"`
+	Tenant        types.String        `tfsdk:"tenant"`
+	Timestamp     rfc3339Value        `tfsdk:"timestamp"`
+	ObjectCount   types.Int64         `tfsdk:"object_count"`
+	Bundle        normalizedJSONValue `tfsdk:"bundle"`
+}
+
+type spConfigImportPreviewDataSourceModel struct {
+	APIVersion   types.String                `tfsdk:"api_version"`
+	Bundle       normalizedJSONValue         `tfsdk:"bundle"`
+	IncludeTypes types.List                  `tfsdk:"include_types"`
+	ExcludeTypes types.List                  `tfsdk:"exclude_types"`
+	Timeout      types.String                `tfsdk:"timeout"`
+	JobID        types.String                `tfsdk:"job_id"`
+	Status       types.String                `tfsdk:"status"`
+	HasErrors    types.Bool                  `tfsdk:"has_errors"`
+	Results      []spConfigImportResultModel `tfsdk:"results"`
+}
+
+// spConfigImportResultModel maps the report of an import job for an object
+// type.
+type spConfigImportResultModel struct {
+	ObjectType      types.String          `tfsdk:"object_type"`
+	Infos           []types.String        `tfsdk:"infos"`
+	Warnings        []types.String        `tfsdk:"warnings"`
+	Errors          []types.String        `tfsdk:"errors"`
+	ImportedObjects []namedReferenceModel `tfsdk:"imported_objects"`
+}
+
+type spConfigObjectTypeModel struct {
+	ObjectType          types.String `tfsdk:"object_type"`
+	ReferenceExtractors types.List   `tfsdk:"reference_extractors"`
+	SignatureRequired   types.Bool   `tfsdk:"signature_required"`
+	AlwaysResolveByID   types.Bool   `tfsdk:"always_resolve_by_id"`
+	LegacyObject        types.Bool   `tfsdk:"legacy_object"`
+	OnePerTenant        types.Bool   `tfsdk:"one_per_tenant"`
+	Exportable          types.Bool   `tfsdk:"exportable"`
+}
+
+type spConfigObjectTypesDataSourceModel struct {
+	APIVersion      types.String              `tfsdk:"api_version"`
+	ObjectTypes     []spConfigObjectTypeModel `tfsdk:"object_types"`
+	ExportableTypes types.List                `tfsdk:"exportable_types"`
+}
+
+func serializeSpConfigObjectTypeData(ctx context.Context, object api_v2025.SpConfigObject) (spConfigObjectTypeModel, diag.Diagnostics) {
+	referenceExtractors, diags := types.ListValueFrom(ctx, types.StringType, object.ReferenceExtractors)
+	if diags.HasError() {
+		return spConfigObjectTypeModel{}, diags
+	}
+
+	return spConfigObjectTypeModel{
+		ObjectType:          types.StringPointerValue(object.ObjectType),
+		ReferenceExtractors: referenceExtractors,
+		SignatureRequired:   types.BoolPointerValue(object.SignatureRequired),
+		AlwaysResolveByID:   types.BoolPointerValue(object.AlwaysResolveById),
+		LegacyObject:        types.BoolPointerValue(object.LegacyObject),
+		OnePerTenant:        types.BoolPointerValue(object.OnePerTenant),
+		Exportable:          types.BoolPointerValue(object.Exportable),
+	}, nil
+}
+
+// addSpConfigJobError adds the error diagnostic for a failed wait on the
+// SP-Config job of an operation (Export or Import). ctx is the context of the
+// wait's caller, to tell the timeout of the wait from a cancelled run.
+func addSpConfigJobError(ctx context.Context, diags *diag.Diagnostics, operation string, jobID string, timeout time.Duration, status string, err error, res *http.Response) {
+	addTaskPollError(ctx, diags, "SP-Config "+operation, operation+" job "+jobID, timeout, status, err, res)
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const spConfigExportDefaultTimeout = 10 * time.Minute
+
+var (
+	_ datasource.DataSource              = &spConfigExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &spConfigExportDataSource{}
+)
+
+func NewSpConfigExportDataSource() datasource.DataSource {
+	return &spConfigExportDataSource{}
+}
+
+type spConfigExportDataSource struct {
+	data *providerData
+}
+
+func (d *spConfigExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sp_config_export"
+}
+
+func (d *spConfigExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers an SP-Config export every time it's read, waits for the export job to finish and exposes the exported JSON bundle.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"include_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Object types to be exported, takes precedence over exclude_types (ex. SOURCE, TRANSFORM, RULE)",
+			},
+			"exclude_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Object types to be excluded from the export",
+			},
+			"object_options": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.ObjectType{AttrTypes: spConfigObjectOptionsAttrTypes},
+				Description: "Restrict the exported objects of a type (the map key) to the given IDs or names",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the export job",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the export job to finish, as a Go duration string (defaults to 10m)",
+			},
+			"job_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the export job.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the export job, COMPLETE since failed jobs fail the read.",
+			},
+			"tenant": schema.StringAttribute{
+				Computed:    true,
+				Description: "Tenant the objects were exported from.",
+			},
+			"timestamp": schema.StringAttribute{
+				CustomType:  rfc3339Type{},
+				Computed:    true,
+				Description: "Date of the export.",
+			},
+			"object_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of exported objects.",
+			},
+			"bundle": schema.StringAttribute{
+				CustomType:  normalizedJSONType{},
+				Computed:    true,
+				Description: "The exported SP-Config JSON bundle, which can be saved to a file and imported into another tenant",
+			},
+		},
+	}
+}
+
+func (d *spConfigExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SpConfigExport data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *spConfigExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SP-Config Export")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state spConfigExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := spConfigExportDefaultTimeout
+	if !state.Timeout.IsNull() {
+		parsed, err := time.ParseDuration(state.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid SP-Config export timeout",
+				err.Error(),
+			)
+			return
+		}
+		timeout = parsed
+	}
+
+	payload := api_v2025.NewExportPayload()
+	payload.Description = state.Description.ValueStringPointer()
+
+	resp.Diagnostics.Append(state.IncludeTypes.ElementsAs(ctx, &payload.IncludeTypes, false)...)
+	resp.Diagnostics.Append(state.ExcludeTypes.ElementsAs(ctx, &payload.ExcludeTypes, false)...)
+
+	objectOptions := make(map[string]spConfigObjectOptionsModel)
+	resp.Diagnostics.Append(state.ObjectOptions.ElementsAs(ctx, &objectOptions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(objectOptions) > 0 {
+		options := make(map[string]api_v2025.ObjectExportImportOptions, len(objectOptions))
+		for objectType, objectOption := range objectOptions {
+			option := api_v2025.NewObjectExportImportOptions()
+			resp.Diagnostics.Append(objectOption.IncludedIDs.ElementsAs(ctx, &option.IncludedIds, false)...)
+			resp.Diagnostics.Append(objectOption.IncludedNames.ElementsAs(ctx, &option.IncludedNames, false)...)
+			options[objectType] = *option
+		}
+		payload.ObjectOptions = &options
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Starting SP-Config export", map[string]any{"include_types": payload.IncludeTypes, "exclude_types": payload.ExcludeTypes})
+
+	job, res, err := client.V2025.SPConfigAPI.ExportSpConfig(ctx).ExportPayload(*payload).Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Start SP-Config Export", err, res)
+		return
+	}
+
+	jobID := job.GetJobId()
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status, res, err := pollTask(pollCtx, "SP-Config export job "+jobID, func(ctx context.Context) (taskPoll, *http.Response, error) {
+		jobStatus, res, err := client.V2025.SPConfigAPI.GetSpConfigExportStatus(ctx, jobID).Execute()
+		if err != nil {
+			return taskPoll{}, res, err
+		}
+
+		status := jobStatus.GetStatus()
+		if status == spConfigJobStatusFailed || status == spConfigJobStatusCancelled {
+			return taskPoll{}, res, &taskFailedError{Task: "Export job " + jobID, Status: status}
+		}
+		return taskPoll{Status: status, Done: status == spConfigJobStatusComplete}, res, nil
+	})
+
+	if err != nil {
+		addSpConfigJobError(ctx, &resp.Diagnostics, "Export", jobID, timeout, status, err, res)
+		return
+	}
+
+	results, res, err := client.V2025.SPConfigAPI.GetSpConfigExport(ctx, jobID).Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read SP-Config Export", err, res)
+		return
+	}
+
+	bundle, err := json.Marshal(results)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Serialize SP-Config Export",
+			err.Error(),
+		)
+		return
+	}
+
+	state.JobID = types.StringValue(jobID)
+	state.Status = types.StringValue(status)
+	state.Tenant = types.StringPointerValue(results.Tenant)
+	state.Timestamp = sailPointTimeValue(results.Timestamp)
+	state.ObjectCount = types.Int64Value(int64(len(results.Objects)))
+	state.Bundle = normalizedJSONStringValue(string(bundle))
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+const spConfigImportPreviewDefaultTimeout = 10 * time.Minute
+
+var (
+	_ datasource.DataSource              = &spConfigImportPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &spConfigImportPreviewDataSource{}
+)
+
+func NewSpConfigImportPreviewDataSource() datasource.DataSource {
+	return &spConfigImportPreviewDataSource{}
+}
+
+type spConfigImportPreviewDataSource struct {
+	data *providerData
+}
+
+func (d *spConfigImportPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sp_config_import_preview"
+}
+
+func (d *spConfigImportPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Previews the import of an SP-Config bundle every time it's read: the import job runs in preview mode, which changes nothing in the tenant, and its report of the objects which would be imported is exposed, so plans show what an import would do.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"bundle": schema.StringAttribute{
+				CustomType:  normalizedJSONType{},
+				Required:    true,
+				Description: "The SP-Config JSON bundle to preview the import of, as exported by sailpoint_sp_config_export",
+			},
+			"include_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Object types to be imported, takes precedence over exclude_types (ex. SOURCE, TRANSFORM, RULE)",
+			},
+			"exclude_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Object types to be excluded from the import",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{durationValidator{}},
+				Description: "How long to wait for the preview job to finish, as a Go duration string (defaults to 10m)",
+			},
+			"job_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the preview job.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the preview job.",
+			},
+			"has_errors": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the import would fail for at least one object",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Report of the preview per object type, sorted by object type",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Object type of the report.",
+						},
+						"infos": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Informational messages about the objects of the type.",
+						},
+						"warnings": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Warnings about the objects of the type.",
+						},
+						"errors": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Errors which would fail the import of the objects of the type.",
+						},
+						"imported_objects": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.ObjectType{AttrTypes: namedReferenceAttrTypes},
+							Description: "Objects which would be created or updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *spConfigImportPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SpConfigImportPreview data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *spConfigImportPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SP-Config Import Preview")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state spConfigImportPreviewDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := timeoutValue(state.Timeout, spConfigImportPreviewDefaultTimeout)
+
+	options := api_v2025.NewImportOptions()
+	resp.Diagnostics.Append(state.IncludeTypes.ElementsAs(ctx, &options.IncludeTypes, false)...)
+	resp.Diagnostics.Append(state.ExcludeTypes.ElementsAs(ctx, &options.ExcludeTypes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The SDK only uploads files, so the bundle goes through a temporary one.
+	bundle, err := os.CreateTemp("", "sp-config-*.json")
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Write SP-Config Bundle", err.Error())
+		return
+	}
+	defer os.Remove(bundle.Name())
+
+	if _, err := bundle.WriteString(state.Bundle.ValueString()); err != nil {
+		bundle.Close()
+		resp.Diagnostics.AddAttributeError(path.Root("bundle"), "Unable to Write SP-Config Bundle", err.Error())
+		return
+	}
+	if _, err := bundle.Seek(0, io.SeekStart); err != nil {
+		bundle.Close()
+		resp.Diagnostics.AddAttributeError(path.Root("bundle"), "Unable to Write SP-Config Bundle", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Starting SP-Config import preview", map[string]any{"include_types": options.IncludeTypes, "exclude_types": options.ExcludeTypes})
+
+	// The SDK closes the file once uploaded.
+	job, res, err := client.V2025.SPConfigAPI.ImportSpConfig(ctx).Data(bundle).Preview(true).Options(*options).Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Start SP-Config Import Preview", err, res)
+		return
+	}
+
+	jobID := job.GetJobId()
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	status, res, err := pollTask(pollCtx, "SP-Config import preview job "+jobID, func(ctx context.Context) (taskPoll, *http.Response, error) {
+		jobStatus, res, err := client.V2025.SPConfigAPI.GetSpConfigImportStatus(ctx, jobID).Execute()
+		if err != nil {
+			return taskPoll{}, res, err
+		}
+
+		status := jobStatus.GetStatus()
+		if status == spConfigJobStatusFailed || status == spConfigJobStatusCancelled {
+			return taskPoll{}, res, &taskFailedError{Task: "Import job " + jobID, Status: status}
+		}
+		return taskPoll{Status: status, Done: status == spConfigJobStatusComplete}, res, nil
+	})
+
+	if err != nil {
+		addSpConfigJobError(ctx, &resp.Diagnostics, "Import", jobID, timeout, status, err, res)
+		return
+	}
+
+	results, res, err := client.V2025.SPConfigAPI.GetSpConfigImport(ctx, jobID).Execute()
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read SP-Config Import Preview", err, res)
+		return
+	}
+
+	state.JobID = types.StringValue(jobID)
+	state.Status = types.StringValue(status)
+	state.Results, state.HasErrors = serializeSpConfigImportResults(results.Results)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// serializeSpConfigImportResults maps the report of an import job, sorted by
+// object type, and whether it has errors.
+func serializeSpConfigImportResults(results map[string]api_v2025.ObjectImportResult1) ([]spConfigImportResultModel, types.Bool) {
+	objectTypes := make([]string, 0, len(results))
+	for objectType := range results {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+
+	hasErrors := false
+	models := make([]spConfigImportResultModel, 0, len(results))
+	for _, objectType := range objectTypes {
+		result := results[objectType]
+		model := spConfigImportResultModel{
+			ObjectType:      types.StringValue(objectType),
+			Infos:           spConfigMessageTexts(result.Infos),
+			Warnings:        spConfigMessageTexts(result.Warnings),
+			Errors:          spConfigMessageTexts(result.Errors),
+			ImportedObjects: make([]namedReferenceModel, 0, len(result.ImportedObjects)),
+		}
+		for _, object := range result.ImportedObjects {
+			model.ImportedObjects = append(model.ImportedObjects, namedReferenceModel{
+				Type: types.StringPointerValue(object.Type),
+				ID:   types.StringPointerValue(object.Id),
+				Name: types.StringPointerValue(object.Name),
+			})
+		}
+		hasErrors = hasErrors || len(result.Errors) > 0
+		models = append(models, model)
+	}
+
+	return models, types.BoolValue(hasErrors)
+}
+
+// spConfigMessageTexts renders the messages of an import report as their key
+// followed by their text.
+func spConfigMessageTexts(messages []api_v2025.SpConfigMessage1) []types.String {
+	texts := make([]types.String, 0, len(messages))
+	for _, message := range messages {
+		texts = append(texts, types.StringValue(fmt.Sprintf("%s: %s", message.Key, message.Text)))
+	}
+	return texts
+}
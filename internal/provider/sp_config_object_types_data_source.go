@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &spConfigObjectTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &spConfigObjectTypesDataSource{}
+)
+
+func NewSpConfigObjectTypesDataSource() datasource.DataSource {
+	return &spConfigObjectTypesDataSource{}
+}
+
+type spConfigObjectTypesDataSource struct {
+	data *providerData
+}
+
+func (d *spConfigObjectTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sp_config_object_types"
+}
+
+func (d *spConfigObjectTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the object types SP-Config can export and import.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"object_types": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Object types supported by SP-Config.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: spConfigObjectTypeDataSourceSchemaAttributes,
+				},
+			},
+			"exportable_types": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the object types which can be used in the include_types and exclude_types of SP-Config exports and imports",
+			},
+		},
+	}
+}
+
+func (d *spConfigObjectTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SpConfigObjectTypes data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *spConfigObjectTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SP-Config Object Types")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state spConfigObjectTypesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, res, err := cachedRead(d.data.cache, cacheKey(client, "sp-config/config-objects"), func() ([]v2025.SpConfigObject, *http.Response, error) {
+		return client.V2025.SPConfigAPI.ListSpConfigObjects(ctx).Execute()
+	})
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read SP-Config Object Types", err, res)
+		return
+	}
+
+	exportableTypes := make([]string, 0)
+	state.ObjectTypes = make([]spConfigObjectTypeModel, 0, len(results))
+	for _, object := range results {
+		objectState, diags := serializeSpConfigObjectTypeData(ctx, object)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ObjectTypes = append(state.ObjectTypes, objectState)
+
+		if object.GetExportable() && object.ObjectType != nil {
+			exportableTypes = append(exportableTypes, object.GetObjectType())
+		}
+	}
+
+	exportableTypesList, diags := types.ListValueFrom(ctx, types.StringType, exportableTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ExportableTypes = exportableTypesList
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
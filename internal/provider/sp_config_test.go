@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestSerializeSpConfigImportResults(t *testing.T) {
+	var results api_v2025.SpConfigImportResults
+	body := `{"results": {
+		"TRANSFORM": {"infos": [], "warnings": [], "errors": [{"key": "INVALID", "text": "Invalid transform", "details": {}}], "importedObjects": []},
+		"SOURCE": {"infos": [{"key": "UPDATED", "text": "Source updated", "details": {}}], "warnings": [], "errors": [], "importedObjects": [{"type": "SOURCE", "id": "2c9180835d191a86015d28455b4b232a", "name": "HR"}]}
+	}}`
+	if err := json.Unmarshal([]byte(body), &results); err != nil {
+		t.Fatal(err)
+	}
+
+	models, hasErrors := serializeSpConfigImportResults(results.Results)
+	if !hasErrors.ValueBool() {
+		t.Error("expected has_errors to be true")
+	}
+	if len(models) != 2 || models[0].ObjectType.ValueString() != "SOURCE" || models[1].ObjectType.ValueString() != "TRANSFORM" {
+		t.Fatalf("expected results sorted by object type, got %v", models)
+	}
+	if models[0].Infos[0].ValueString() != "UPDATED: Source updated" || models[0].ImportedObjects[0].Name.ValueString() != "HR" {
+		t.Errorf("unexpected SOURCE result %v", models[0])
+	}
+}
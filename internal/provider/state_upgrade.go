@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// stateUpgrade moves the JSON state of a resource from a schema version to
+// the next one, such as renaming or dropping attributes. Numbers are
+// json.Number so they keep their precision.
+type stateUpgrade func(state map[string]any) error
+
+// stateVersion returns the schema version of a resource whose states are
+// upgraded by upgrades, each schema change appending its upgrade.
+func stateVersion(upgrades []stateUpgrade) int64 {
+	return int64(len(upgrades))
+}
+
+// stateUpgraders returns the state upgraders of a resource from its list of
+// upgrades. Each resource keeps its upgrades in a <resource>StateUpgrades
+// variable, empty until its schema first changes in a way prior states don't
+// fit. Terraform upgrades a state to the current version in one step, so the
+// upgrader of each prior version chains the following upgrades.
+func stateUpgraders(upgrades []stateUpgrade) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(upgrades))
+	for version := range upgrades {
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgraded, err := upgradeState(req.RawState.JSON, upgrades[version:])
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Upgrade Resource State",
+						fmt.Sprintf("The state of schema version %d could not be upgraded to version %d: %s", version, len(upgrades), err),
+					)
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		}
+	}
+	return upgraders
+}
+
+// upgradeState applies upgrades to a JSON state, in order.
+func upgradeState(raw []byte, upgrades []stateUpgrade) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var state map[string]any
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+	for _, upgrade := range upgrades {
+		if err := upgrade(state); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(state)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestStateUpgraders(t *testing.T) {
+	upgrades := []stateUpgrade{
+		// Version 0 to 1 renames cluster to cluster_id.
+		func(state map[string]any) error {
+			state["cluster_id"] = state["cluster"]
+			delete(state, "cluster")
+			return nil
+		},
+		// Version 1 to 2 drops debug.
+		func(state map[string]any) error {
+			delete(state, "debug")
+			return nil
+		},
+	}
+	if got := stateVersion(upgrades); got != 2 {
+		t.Fatalf("stateVersion() = %d, want 2", got)
+	}
+
+	upgraders := stateUpgraders(upgrades)
+	tests := map[int64]struct {
+		state string
+		want  string
+	}{
+		0: {`{"cluster":"abc","debug":true,"size":9007199254740993}`, `{"cluster_id":"abc","size":9007199254740993}`},
+		1: {`{"cluster_id":"abc","debug":true,"size":9007199254740993}`, `{"cluster_id":"abc","size":9007199254740993}`},
+	}
+	for version, tt := range tests {
+		upgrader, ok := upgraders[version]
+		if !ok {
+			t.Fatalf("no upgrader of version %d", version)
+		}
+		req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tt.state)}}
+		var resp resource.UpgradeStateResponse
+		upgrader.StateUpgrader(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("version %d: %v", version, resp.Diagnostics)
+		}
+		if got := string(resp.DynamicValue.JSON); got != tt.want {
+			t.Errorf("version %d: got %s, want %s", version, got, tt.want)
+		}
+	}
+	if _, ok := upgraders[2]; ok {
+		t.Error("the current version has an upgrader")
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	dataSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	suggestedEntitlementDescriptionDataSourceSchemaAttributes = map[string]dataSchema.Attribute{
+		"id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the suggestion.",
+		},
+		"name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the entitlement.",
+		},
+		"display_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Display name of the entitlement.",
+		},
+		"type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Type of the entitlement, such as group.",
+		},
+		"attribute": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Attribute of the accounts granting the entitlement.",
+		},
+		"value": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Value of the entitlement.",
+		},
+		"source_id": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the source of the entitlement.",
+		},
+		"source_name": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the source of the entitlement.",
+		},
+		"description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Current description of the entitlement",
+		},
+		"suggested_description": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Description suggested by SailPoint AI for the entitlement",
+		},
+		"status": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the suggestion, such as suggested, approved or denied.",
+		},
+		"approved_by": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "Identity which approved the suggestion.",
+		},
+		"approved_type": dataSchema.StringAttribute{
+			Computed:    true,
+			Description: "How the suggestion was approved.",
+		},
+		"approved_when": dataSchema.StringAttribute{
+			CustomType:  rfc3339Type{},
+			Computed:    true,
+			Description: "Date the suggestion was approved.",
+		},
+	}
+)
+
+type suggestedEntitlementDescriptionModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	DisplayName          types.String `tfsdk:"display_name"`
+	Type                 types.String `tfsdk:"type"`
+	Attribute            types.String `tfsdk:"attribute"`
+	Value                types.String `tfsdk:"value"`
+	SourceID             types.String `tfsdk:"source_id"`
+	SourceName           types.String `tfsdk:"source_name"`
+	Description          types.String `tfsdk:"description"`
+	SuggestedDescription types.String `tfsdk:"suggested_description"`
+	Status               types.String `tfsdk:"status"`
+	ApprovedBy           types.String `tfsdk:"approved_by"`
+	ApprovedType         types.String `tfsdk:"approved_type"`
+	ApprovedWhen         rfc3339Value `tfsdk:"approved_when"`
+}
+
+type suggestedEntitlementDescriptionsDataSourceModel struct {
+	APIVersion types.String `tfsdk:"api_version"`
+	paginationModel
+	Sorters                          types.String                           `tfsdk:"sorters"`
+	Filters                          types.String                           `tfsdk:"filters"`
+	RequestedByAnyone                types.Bool                             `tfsdk:"requested_by_anyone"`
+	ShowPendingStatusOnly            types.Bool                             `tfsdk:"show_pending_status_only"`
+	SuggestedEntitlementDescriptions []suggestedEntitlementDescriptionModel `tfsdk:"suggested_entitlement_descriptions"`
+}
+
+func serializeSuggestedEntitlementDescriptionData(sed api_v2025.Sed) suggestedEntitlementDescriptionModel {
+	return suggestedEntitlementDescriptionModel{
+		ID:                   types.StringPointerValue(sed.Id),
+		Name:                 types.StringPointerValue(sed.Name),
+		DisplayName:          types.StringPointerValue(sed.DisplayName),
+		Type:                 types.StringPointerValue(sed.Type),
+		Attribute:            types.StringPointerValue(sed.Attribute),
+		Value:                types.StringPointerValue(sed.Value),
+		SourceID:             types.StringPointerValue(sed.SourceId),
+		SourceName:           types.StringPointerValue(sed.SourceName),
+		Description:          types.StringPointerValue(sed.Description),
+		SuggestedDescription: types.StringPointerValue(sed.SuggestedDescription),
+		Status:               types.StringPointerValue(sed.Status),
+		ApprovedBy:           types.StringPointerValue(sed.ApprovedBy),
+		ApprovedType:         types.StringPointerValue(sed.ApprovedType),
+		ApprovedWhen:         sailPointTimeValue(sed.ApprovedWhen),
+	}
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v2025 "github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &suggestedEntitlementDescriptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &suggestedEntitlementDescriptionsDataSource{}
+)
+
+func NewSuggestedEntitlementDescriptionsDataSource() datasource.DataSource {
+	return &suggestedEntitlementDescriptionsDataSource{}
+}
+
+type suggestedEntitlementDescriptionsDataSource struct {
+	data *providerData
+}
+
+func (d *suggestedEntitlementDescriptionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suggested_entitlement_descriptions"
+}
+
+func (d *suggestedEntitlementDescriptionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the entitlement descriptions suggested by SailPoint AI.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"limit":       limitDataSourceSchemaAttribute,
+			"offset":      offsetDataSourceSchemaAttribute,
+			"max_results": maxResultsDataSourceSchemaAttribute,
+			"total":       totalDataSourceSchemaAttribute,
+			"count_only":  countOnlyDataSourceSchemaAttribute,
+			"sorters":     sortersDataSourceSchemaAttribute,
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter results using the standard syntax described in V3 API Standard Collection Parameters (ex. sourceId eq \"2c91808...\")",
+			},
+			"requested_by_anyone": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to return the suggestions requested by anyone instead of only the ones requested by the current user",
+			},
+			"show_pending_status_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to only return the suggestions in \"suggested\" or \"approved\" status",
+			},
+			"suggested_entitlement_descriptions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Suggested entitlement descriptions of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: suggestedEntitlementDescriptionDataSourceSchemaAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *suggestedEntitlementDescriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint SuggestedEntitlementDescriptions data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *suggestedEntitlementDescriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Suggested Entitlement Descriptions")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state suggestedEntitlementDescriptionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := state.Filters.ValueString()
+	tflog.Debug(ctx, "Reading Suggested Entitlement Descriptions filters", map[string]any{"filters": filters})
+
+	request := client.V2025.SuggestedEntitlementDescriptionAPI.ListSeds(ctx)
+	if filters != "" {
+		request = request.Filters(filters)
+	}
+	if !state.RequestedByAnyone.IsNull() {
+		request = request.RequestedByAnyone(state.RequestedByAnyone.ValueBool())
+	}
+	if !state.ShowPendingStatusOnly.IsNull() {
+		request = request.ShowPendingStatusOnly(state.ShowPendingStatusOnly.ValueBool())
+	}
+	if !state.Sorters.IsNull() {
+		request = request.Sorters(state.Sorters.ValueString())
+	}
+
+	results, res, err := paginate[v2025.Sed](request, &state.paginationModel)
+
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Suggested Entitlement Descriptions", err, res)
+		return
+	}
+
+	state.SuggestedEntitlementDescriptions = make([]suggestedEntitlementDescriptionModel, 0, len(results))
+	for _, sed := range results {
+		state.SuggestedEntitlementDescriptions = append(state.SuggestedEntitlementDescriptions, serializeSuggestedEntitlementDescriptionData(sed))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
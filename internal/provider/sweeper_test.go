@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Sweepers delete the objects acceptance tests left in the test tenant, as
+// when a run was interrupted. Objects created by acceptance tests are named
+// with acctest.RandomWithPrefix(sweepPrefix), and sweepers only delete
+// objects named with that prefix. Run them with
+// go test ./internal/provider -sweep=tenant, the SAIL_BASE_URL, SAIL_CLIENT_ID
+// and SAIL_CLIENT_SECRET environment variables selecting the tenant.
+
+// sweepPrefix starts the names of the objects created by acceptance tests.
+const sweepPrefix = "tf-acc-test"
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("sailpoint_managed_cluster", &resource.Sweeper{
+		Name: "sailpoint_managed_cluster",
+		F:    sweepManagedClusters,
+	})
+}
+
+// sweepClient returns a client of the tenant selected by the environment
+// variables. Tenants have no regions, so the -sweep value is ignored.
+func sweepClient(ctx context.Context) (*sailpoint.APIClient, error) {
+	baseURL := os.Getenv("SAIL_BASE_URL")
+	clientID := os.Getenv("SAIL_CLIENT_ID")
+	clientSecret := os.Getenv("SAIL_CLIENT_SECRET")
+	if baseURL == "" || clientID == "" || clientSecret == "" {
+		return nil, errors.New("SAIL_BASE_URL, SAIL_CLIENT_ID and SAIL_CLIENT_SECRET must be set to sweep a tenant")
+	}
+
+	tokenURL := baseURL + "/oauth/token"
+	token, err := (&clientcredentials.Config{ClientID: clientID, ClientSecret: clientSecret, TokenURL: tokenURL}).Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("requesting an access token: %w", err)
+	}
+
+	configuration := sailpoint.NewConfiguration(sailpoint.ClientConfiguration{
+		BaseURL:      baseURL,
+		ClientId:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+		Token:        token.AccessToken,
+	})
+	configuration.ConsumerIdentifier = "terraform-provider-sailpoint-sweeper"
+	return sailpoint.NewAPIClient(configuration), nil
+}
+
+// sweepable reports whether an object was created by an acceptance test.
+func sweepable(name string) bool {
+	return strings.HasPrefix(name, sweepPrefix)
+}
+
+func sweepManagedClusters(_ string) error {
+	ctx := context.Background()
+	client, err := sweepClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The clusters can't be filtered by a prefix of their name.
+	pagination := paginationModel{MaxResults: types.Int64Value(0)}
+	clusters, _, err := paginate[managedClusterRead](managedClustersRequest{request: client.V2025.ManagedClustersAPI.GetManagedClusters(ctx)}, &pagination)
+	if err != nil {
+		return fmt.Errorf("listing managed clusters: %w", err)
+	}
+
+	var errs []error
+	for _, read := range clusters {
+		cluster := read.Cluster
+		if !sweepable(cluster.GetName()) {
+			continue
+		}
+		if res, err := client.V2025.ManagedClustersAPI.DeleteManagedCluster(ctx, cluster.GetId()).Execute(); err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+			errs = append(errs, fmt.Errorf("deleting managed cluster %s (%s): %w", cluster.GetName(), cluster.GetId(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ list.ListResource              = &tagAssignmentSetListResource{}
+	_ list.ListResourceWithConfigure = &tagAssignmentSetListResource{}
+)
+
+func NewTagAssignmentSetListResource() list.ListResource {
+	return &tagAssignmentSetListResource{}
+}
+
+// tagAssignmentSetListResource lists the tags of the tenant, each with the
+// objects it is assigned to.
+type tagAssignmentSetListResource struct {
+	data *providerData
+}
+
+type tagAssignmentSetListResourceModel struct {
+	Filters types.String `tfsdk:"filters"`
+}
+
+func (l *tagAssignmentSetListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_assignment_set"
+}
+
+func (l *tagAssignmentSetListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the tags of the tenant, in alphabetical order, each with the objects it is assigned to.",
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{filterSyntaxValidator{}},
+				Description: "Filter of the tags listed, using the standard syntax described in V3 API Standard Collection Parameters (ex. name sw \"PROD\").",
+			},
+		},
+	}
+}
+
+func (l *tagAssignmentSetListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint TagAssignmentSet list resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.data = data
+}
+
+func (l *tagAssignmentSetListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, "Listing Tag Assignment Sets")
+
+	var (
+		config tagAssignmentSetListResourceModel
+		diags  diag.Diagnostics
+	)
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// A limit of 0 lists all the tags.
+	pagination := paginationModel{MaxResults: types.Int64Value(req.Limit)}
+	request := l.data.client().V2025.TagsAPI.ListTags(ctx).Sorters("name")
+	if !config.Filters.IsNull() {
+		request = request.Filters(config.Filters.ValueString())
+	}
+	tags, res, err := paginate[api_v2025.Tag](request, &pagination)
+	if err != nil {
+		l.data.rateLimit.warnRateLimit(&diags)
+		addAPIError(ctx, &diags, "Unable to List Tags", err, res)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// The objects of each tag are only read when the resources are.
+	objects := make([][]taggedObjectRefModel, len(tags))
+	if req.IncludeResource {
+		responses := make([]*http.Response, len(tags))
+		failed, err := forEachConcurrently(ctx, len(tags), detailFetchWorkers, func(ctx context.Context, i int) error {
+			pagination := paginationModel{MaxResults: types.Int64Value(0)}
+			request := l.data.client().V2025.TaggedObjectsAPI.ListTaggedObjects(ctx).Filters("tagName eq " + quoteFilterString(tags[i].Name))
+			tagged, res, err := paginate[api_v2025.TaggedObject](request, &pagination)
+			objects[i], responses[i] = taggedObjectRefs(tagged), res
+			return err
+		})
+		if err != nil {
+			l.data.rateLimit.warnRateLimit(&diags)
+			if failed < 0 {
+				addAPIError(ctx, &diags, "Unable to List Tagged Objects", err, nil)
+			} else {
+				addAPIError(ctx, &diags, fmt.Sprintf("Unable to List the Objects Tagged %s", tags[failed].Name), err, responses[failed])
+			}
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+	l.data.rateLimit.warnRateLimit(&diags)
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, tag := range tags {
+			result := req.NewListResult(ctx)
+			result.DisplayName = tag.Name
+			if i == 0 {
+				// Rate limit warnings
+				result.Diagnostics.Append(diags...)
+			}
+			setIDIdentity(ctx, result.Identity, types.StringValue(tag.Name), &result.Diagnostics)
+
+			if req.IncludeResource {
+				state := tagAssignmentSetResourceModel{
+					ID:      types.StringValue(tag.Name),
+					Tag:     types.StringValue(tag.Name),
+					Objects: objects[i],
+				}
+				result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ resource.Resource                 = &tagAssignmentSetResource{}
+	_ resource.ResourceWithConfigure    = &tagAssignmentSetResource{}
+	_ resource.ResourceWithImportState  = &tagAssignmentSetResource{}
+	_ resource.ResourceWithIdentity     = &tagAssignmentSetResource{}
+	_ resource.ResourceWithUpgradeState = &tagAssignmentSetResource{}
+	_ resource.ResourceWithModifyPlan   = &tagAssignmentSetResource{}
+)
+
+var tagAssignmentSetStateUpgrades []stateUpgrade
+
+// taggedObjectTypePattern matches the types of objects which can be tagged.
+var taggedObjectTypePattern = regexp.MustCompile(`^(ACCESS_PROFILE|APPLICATION|CAMPAIGN|ENTITLEMENT|IDENTITY|ROLE|SOD_POLICY|SOURCE)$`)
+
+func NewTagAssignmentSetResource() resource.Resource {
+	return &tagAssignmentSetResource{}
+}
+
+type tagAssignmentSetResource struct {
+	client             *sailpoint.APIClient
+	rateLimit          *rateLimitUsage
+	validateReferences bool
+}
+
+type tagAssignmentSetResourceModel struct {
+	ID      types.String           `tfsdk:"id"`
+	Tag     types.String           `tfsdk:"tag"`
+	Objects []taggedObjectRefModel `tfsdk:"objects"`
+}
+
+type taggedObjectRefModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+func (r *tagAssignmentSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_assignment_set"
+}
+
+func (r *tagAssignmentSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     stateVersion(tagAssignmentSetStateUpgrades),
+		Description: "Assigns a tag to a set of objects with the bulk tagging endpoints. Objects added to or removed from the set are tagged or untagged on update, and objects whose tag was removed outside of Terraform are tagged again in the next plan. Objects tagged outside of Terraform are left as they are, and destroying the resource removes the tag from the objects of the set. Importing the resource by tag adopts every object with the tag.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Tag assigned to the objects.",
+			},
+			"tag": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Tag assigned to the objects.",
+			},
+			"objects": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "Objects the tag is assigned to.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringPatternValidator{pattern: taggedObjectTypePattern, message: "must be one of ACCESS_PROFILE, APPLICATION, CAMPAIGN, ENTITLEMENT, IDENTITY, ROLE, SOD_POLICY or SOURCE"}},
+							Description: "Type of the object, one of ACCESS_PROFILE, APPLICATION, CAMPAIGN, ENTITLEMENT, IDENTITY, ROLE, SOD_POLICY or SOURCE.",
+						},
+						"id": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{sailPointIDValidator{}},
+							Description: "ID of the object.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *tagAssignmentSetResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(tagAssignmentSetStateUpgrades)
+}
+
+func (r *tagAssignmentSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint TagAssignmentSet resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client()
+	r.rateLimit = data.rateLimit
+	r.validateReferences = data.validateReferences
+}
+
+func (r *tagAssignmentSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing references other objects in destroy plans.
+	if !r.validateReferences || req.Plan.Raw.IsNull() {
+		return
+	}
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	planned := taggedObjectReferences(ctx, req.Plan, &resp.Diagnostics)
+	var prior []reference
+	if !req.State.Raw.IsNull() {
+		prior = taggedObjectReferences(ctx, req.State, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkReferences(ctx, r.client, addedReferences(planned, prior), &resp.Diagnostics)
+}
+
+func (r *tagAssignmentSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating tag assignment set resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan tagAssignmentSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.tag(ctx, plan.Tag.ValueString(), plan.Objects)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID is the tag, there is a single set of objects per tag.
+	plan.ID = plan.Tag
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIDIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+func (r *tagAssignmentSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading tag assignment set resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var state tagAssignmentSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pagination := paginationModel{MaxResults: types.Int64Value(0)}
+	request := r.client.V2025.TaggedObjectsAPI.ListTaggedObjects(ctx).Filters("tagName eq " + quoteFilterString(state.Tag.ValueString()))
+	tagged, res, err := paginate[api_v2025.TaggedObject](request, &pagination)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Tagged Objects", err, res)
+		return
+	}
+
+	// Objects which lost the tag drop out of the set, so the next plan tags
+	// them again. An imported set, which has no tag yet, adopts every object
+	// with the tag.
+	if state.Tag.IsNull() {
+		state.Tag = state.ID
+		state.Objects = taggedObjectRefs(tagged)
+	} else {
+		state.Objects = keepTaggedObjects(state.Objects, tagged)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	setIDIdentity(ctx, resp.Identity, state.ID, &resp.Diagnostics)
+}
+
+func (r *tagAssignmentSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating tag assignment set resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var plan, state tagAssignmentSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, removed := diffTaggedObjects(state.Objects, plan.Objects)
+	resp.Diagnostics.Append(r.tag(ctx, plan.Tag.ValueString(), added)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.untag(ctx, plan.Tag.ValueString(), removed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIDIdentity(ctx, resp.Identity, plan.ID, &resp.Diagnostics)
+}
+
+func (r *tagAssignmentSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting tag assignment set resource")
+	defer r.rateLimit.warnRateLimit(&resp.Diagnostics)
+
+	var state tagAssignmentSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.untag(ctx, state.Tag.ValueString(), state.Objects)...)
+}
+
+func (r *tagAssignmentSetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema
+}
+
+// ImportState imports the objects with a tag by the tag, or by identity.
+func (r *tagAssignmentSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// tag appends the tag to the objects, keeping their other tags.
+func (r *tagAssignmentSetResource) tag(ctx context.Context, tag string, objects []taggedObjectRefModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(objects) == 0 {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Tagging objects", map[string]any{"tag": tag, "count": len(objects)})
+
+	request := api_v2025.BulkAddTaggedObject{ObjectRefs: taggedObjectDtos(objects), Tags: []string{tag}}
+	request.SetOperation("APPEND")
+	_, res, err := r.client.V2025.TaggedObjectsAPI.SetTagsToManyObjects(ctx).BulkAddTaggedObject(request).Execute()
+	if err != nil {
+		addAPIError(ctx, &diags, "Unable to Tag Objects", err, res)
+	}
+	return diags
+}
+
+// untag removes the tag from the objects, keeping their other tags.
+func (r *tagAssignmentSetResource) untag(ctx context.Context, tag string, objects []taggedObjectRefModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(objects) == 0 {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Untagging objects", map[string]any{"tag": tag, "count": len(objects)})
+
+	request := api_v2025.BulkRemoveTaggedObject{ObjectRefs: taggedObjectDtos(objects), Tags: []string{tag}}
+	res, err := r.client.V2025.TaggedObjectsAPI.DeleteTagsToManyObject(ctx).BulkRemoveTaggedObject(request).Execute()
+	if err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+		addAPIError(ctx, &diags, "Unable to Untag Objects", err, res)
+	}
+	return diags
+}
+
+func taggedObjectDtos(objects []taggedObjectRefModel) []api_v2025.TaggedObjectDto {
+	dtos := make([]api_v2025.TaggedObjectDto, 0, len(objects))
+	for _, object := range objects {
+		dtos = append(dtos, api_v2025.TaggedObjectDto{Type: object.Type.ValueStringPointer(), Id: object.ID.ValueStringPointer()})
+	}
+	return dtos
+}
+
+// taggedObjectRefs returns the references of the tagged objects.
+func taggedObjectRefs(tagged []api_v2025.TaggedObject) []taggedObjectRefModel {
+	refs := make([]taggedObjectRefModel, 0, len(tagged))
+	for _, object := range tagged {
+		ref := object.GetObjectRef()
+		refs = append(refs, taggedObjectRefModel{Type: types.StringValue(ref.GetType()), ID: types.StringValue(ref.GetId())})
+	}
+	return refs
+}
+
+// keepTaggedObjects returns the objects which are among the tagged ones.
+func keepTaggedObjects(objects []taggedObjectRefModel, tagged []api_v2025.TaggedObject) []taggedObjectRefModel {
+	taggedRefs := make(map[taggedObjectRefModel]bool, len(tagged))
+	for _, ref := range taggedObjectRefs(tagged) {
+		taggedRefs[ref] = true
+	}
+
+	kept := make([]taggedObjectRefModel, 0, len(objects))
+	for _, object := range objects {
+		if taggedRefs[object] {
+			kept = append(kept, object)
+		}
+	}
+	return kept
+}
+
+// diffTaggedObjects returns the objects of planned which are not in prior,
+// and the ones of prior which are not in planned.
+func diffTaggedObjects(prior, planned []taggedObjectRefModel) (added, removed []taggedObjectRefModel) {
+	inPrior := make(map[taggedObjectRefModel]bool, len(prior))
+	for _, object := range prior {
+		inPrior[object] = true
+	}
+	inPlanned := make(map[taggedObjectRefModel]bool, len(planned))
+	for _, object := range planned {
+		inPlanned[object] = true
+		if !inPrior[object] {
+			added = append(added, object)
+		}
+	}
+	for _, object := range prior {
+		if !inPlanned[object] {
+			removed = append(removed, object)
+		}
+	}
+	return added, removed
+}
+
+// taggedObjectReferenceKinds maps the types of tagged objects to the kind of
+// object they reference. Applications can't be read by ID and aren't checked.
+var taggedObjectReferenceKinds = map[string]referenceKind{
+	"ACCESS_PROFILE": accessProfileReference,
+	"CAMPAIGN":       campaignReference,
+	"ENTITLEMENT":    entitlementReference,
+	"IDENTITY":       identityReference,
+	"ROLE":           roleReference,
+	"SOD_POLICY":     sodPolicyReference,
+	"SOURCE":         sourceReference,
+}
+
+// taggedObjectReferences returns the references of the objects of a plan or
+// state, none when they are unknown.
+func taggedObjectReferences(ctx context.Context, data attributeGetter, diags *diag.Diagnostics) []reference {
+	var set types.Set
+	diags.Append(data.GetAttribute(ctx, path.Root("objects"), &set)...)
+	var objects []taggedObjectRefModel
+	diags.Append(set.ElementsAs(ctx, &objects, true)...)
+
+	var references []reference
+	for _, object := range objects {
+		if kind, ok := taggedObjectReferenceKinds[object.Type.ValueString()]; ok {
+			references = append(references, reference{path: path.Root("objects"), kind: kind, id: object.ID})
+		}
+	}
+	return references
+}
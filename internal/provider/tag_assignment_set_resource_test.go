@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func taggedObjectRef(objectType, id string) taggedObjectRefModel {
+	return taggedObjectRefModel{Type: types.StringValue(objectType), ID: types.StringValue(id)}
+}
+
+func TestDiffTaggedObjects(t *testing.T) {
+	prior := []taggedObjectRefModel{taggedObjectRef("ROLE", "r1"), taggedObjectRef("ROLE", "r2")}
+	planned := []taggedObjectRefModel{taggedObjectRef("ROLE", "r2"), taggedObjectRef("SOURCE", "r1")}
+
+	added, removed := diffTaggedObjects(prior, planned)
+	if len(added) != 1 || added[0] != taggedObjectRef("SOURCE", "r1") {
+		t.Errorf("unexpected added objects %v", added)
+	}
+	if len(removed) != 1 || removed[0] != taggedObjectRef("ROLE", "r1") {
+		t.Errorf("unexpected removed objects %v", removed)
+	}
+}
+
+func TestKeepTaggedObjects(t *testing.T) {
+	var tagged []api_v2025.TaggedObject
+	body := `[{"objectRef": {"type": "ROLE", "id": "r1"}, "tags": ["PCI"]}, {"objectRef": {"type": "ROLE", "id": "r3"}, "tags": ["PCI"]}]`
+	if err := json.Unmarshal([]byte(body), &tagged); err != nil {
+		t.Fatal(err)
+	}
+
+	kept := keepTaggedObjects([]taggedObjectRefModel{taggedObjectRef("ROLE", "r1"), taggedObjectRef("ROLE", "r2")}, tagged)
+	if len(kept) != 1 || kept[0] != taggedObjectRef("ROLE", "r1") {
+		t.Errorf("unexpected kept objects %v", kept)
+	}
+}
+
+func TestTagAssignmentSetListResourceList(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeSailPoint(t)
+	fake.respond(http.MethodGet, "/v2025/tags", http.StatusOK, `[
+		{"id": "t1", "name": "PROD", "created": "2024-01-01T00:00:00Z", "modified": "2024-01-01T00:00:00Z", "tagCategoryRefs": []},
+		{"id": "t2", "name": "PROD_EU", "created": "2024-01-01T00:00:00Z", "modified": "2024-01-01T00:00:00Z", "tagCategoryRefs": []}
+	]`)
+	fake.handle(http.MethodGet, "/v2025/tagged-objects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch filters := r.URL.Query().Get("filters"); filters {
+		case `tagName eq "PROD"`:
+			_, _ = w.Write([]byte(`[{"objectRef": {"type": "ROLE", "id": "r1"}, "tags": ["PROD", "PROD_EU"]}, {"objectRef": {"type": "SOURCE", "id": "s1"}, "tags": ["PROD"]}]`))
+		case `tagName eq "PROD_EU"`:
+			_, _ = w.Write([]byte(`[{"objectRef": {"type": "ROLE", "id": "r1"}, "tags": ["PROD", "PROD_EU"]}]`))
+		default:
+			t.Errorf("unexpected filters %q", filters)
+		}
+	})
+
+	l := NewTagAssignmentSetListResource().(*tagAssignmentSetListResource)
+	var configureResp resource.ConfigureResponse
+	l.Configure(ctx, resource.ConfigureRequest{ProviderData: fake.providerData()}, &configureResp)
+
+	var schemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	var resourceSchemaResp resource.SchemaResponse
+	NewTagAssignmentSetResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{"filters": tftypes.NewValue(tftypes.String, `name sw "PROD"`)}),
+		},
+		IncludeResource:        true,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: idIdentitySchema,
+	}
+	var stream list.ListResultsStream
+	l.List(ctx, req, &stream)
+
+	objects := map[string]int{}
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatal(result.Diagnostics)
+		}
+		var identity idIdentityModel
+		var state tagAssignmentSetResourceModel
+		result.Diagnostics.Append(result.Identity.Get(ctx, &identity)...)
+		result.Diagnostics.Append(result.Resource.Get(ctx, &state)...)
+		if result.Diagnostics.HasError() {
+			t.Fatal(result.Diagnostics)
+		}
+		if identity.ID != state.Tag || state.ID != state.Tag {
+			t.Errorf("%s: identity %s, ID %s, tag %s", result.DisplayName, identity.ID, state.ID, state.Tag)
+		}
+		objects[result.DisplayName] = len(state.Objects)
+	}
+	if len(objects) != 2 || objects["PROD"] != 2 || objects["PROD_EU"] != 1 {
+		t.Errorf("unexpected objects by tag %v", objects)
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// taskPollWaitMin and taskPollWaitMax bound the wait between two polls of
+	// an asynchronous task, which grows by half on every poll so short tasks
+	// complete quickly and long ones don't flood the API.
+	taskPollWaitMin = 2 * time.Second
+	taskPollWaitMax = 30 * time.Second
+)
+
+// taskFailedError reports an asynchronous task which finished without
+// succeeding.
+type taskFailedError struct {
+	Task   string
+	Status string
+}
+
+func (e *taskFailedError) Error() string {
+	return fmt.Sprintf("%s finished with status %s", e.Task, e.Status)
+}
+
+// taskPoll is the outcome of a poll of an asynchronous task: its status, and
+// whether it completed successfully. Polls of a task which failed return a
+// *taskFailedError instead.
+type taskPoll struct {
+	Status string
+	Done   bool
+}
+
+// pollTask calls poll until the task completes, fails, or ctx is done, which
+// is how callers bound the wait. The first poll is sent right away. task
+// describes the task in logs and errors (ex. "SP-Config export job 1234").
+// Status changes are logged at info level and every poll at debug level.
+//
+// It returns the status of the last poll, and the response and error of the
+// failed poll, or the error of ctx, wrapped with the last status.
+func pollTask(ctx context.Context, task string, poll func(ctx context.Context) (taskPoll, *http.Response, error)) (string, *http.Response, error) {
+	start := time.Now()
+	wait := taskPollWaitMin
+	status := ""
+
+	for {
+		result, res, err := poll(ctx)
+		if err != nil {
+			return status, res, err
+		}
+
+		fields := map[string]any{"task": task, "status": result.Status, "elapsed": time.Since(start).Round(time.Second).String()}
+		if result.Status != status {
+			tflog.Info(ctx, "Task status changed", fields)
+		} else {
+			tflog.Debug(ctx, "Polled task", fields)
+		}
+		status = result.Status
+
+		if result.Done {
+			return status, res, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, nil, fmt.Errorf("%s didn't complete, its last status was %q: %w", task, status, ctx.Err())
+		case <-time.After(wait):
+		}
+		wait = min(wait*3/2, taskPollWaitMax)
+	}
+}
+
+// addTaskPollError adds the error diagnostic for a failed pollTask wait of at
+// most timeout on a task, telling a failed task from a timed out wait and a
+// cancelled run. operation names what the task does in the summary (ex.
+// "SP-Config Export"), task identifies it in the detail (ex. "Export job
+// 1234"). ctx is the context of the wait's caller.
+func addTaskPollError(ctx context.Context, diags *diag.Diagnostics, operation string, task string, timeout time.Duration, status string, err error, res *http.Response) {
+	var failed *taskFailedError
+	switch {
+	case errors.As(err, &failed):
+		diags.AddError(fmt.Sprintf("%s Failed", operation), failed.Error())
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		diags.AddError(
+			fmt.Sprintf("%s Timed Out", operation),
+			fmt.Sprintf("%s didn't complete within %s, last status was %s", task, timeout, status),
+		)
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		diags.AddError(fmt.Sprintf("%s Cancelled", operation), err.Error())
+	default:
+		addAPIError(ctx, diags, fmt.Sprintf("Unable to Read %s Status", operation), err, res)
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestPollTask(t *testing.T) {
+	statuses := []string{"IN_PROGRESS", "COMPLETE"}
+	calls := 0
+
+	status, _, err := pollTask(context.Background(), "test task", func(_ context.Context) (taskPoll, *http.Response, error) {
+		status := statuses[calls]
+		calls++
+		return taskPoll{Status: status, Done: status == "COMPLETE"}, nil, nil
+	})
+
+	if err != nil || status != "COMPLETE" || calls != 2 {
+		t.Errorf("got status %s after %d calls: %v", status, calls, err)
+	}
+}
+
+func TestPollTaskFailures(t *testing.T) {
+	failed := &taskFailedError{Task: "test task", Status: "FAILED"}
+	_, _, err := pollTask(context.Background(), "test task", func(_ context.Context) (taskPoll, *http.Response, error) {
+		return taskPoll{}, nil, failed
+	})
+	if !errors.Is(err, failed) {
+		t.Errorf("expected the failure to be returned, got: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	status, _, err := pollTask(ctx, "test task", func(_ context.Context) (taskPoll, *http.Response, error) {
+		return taskPoll{Status: "PENDING"}, nil, nil
+	})
+	if status != "PENDING" || !errors.Is(err, context.Canceled) {
+		t.Errorf("got status %s: %v", status, err)
+	}
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	sailpoint "github.com/sailpoint-oss/golang-sdk/v2"
+)
+
+// Completion statuses of the tasks of the task management API. Tasks which
+// are not completed have none.
+const (
+	taskStatusSuccess    = "SUCCESS"
+	taskStatusWarning    = "WARNING"
+	taskStatusError      = "ERROR"
+	taskStatusTerminated = "TERMINATED"
+	taskStatusTempError  = "TEMPERROR"
+)
+
+// taskStatusPending is the status logged for tasks which are not completed.
+const taskStatusPending = "PENDING"
+
+// waitTaskStatus polls the task management API until the task completes,
+// which it does successfully with the SUCCESS or WARNING statuses. task
+// describes the task as in pollTask.
+func waitTaskStatus(ctx context.Context, client *sailpoint.APIClient, task string, taskID string) (string, *http.Response, error) {
+	return pollTask(ctx, task, func(ctx context.Context) (taskPoll, *http.Response, error) {
+		status, res, err := client.V2025.TaskManagementAPI.GetTaskStatus(ctx, taskID).Execute()
+		if err != nil {
+			return taskPoll{}, res, err
+		}
+
+		completion, ok := status.GetCompletionStatusOk()
+		if !ok || completion == nil || *completion == "" {
+			return taskPoll{Status: taskStatusPending}, res, nil
+		}
+		switch *completion {
+		case taskStatusSuccess, taskStatusWarning:
+			return taskPoll{Status: *completion, Done: true}, res, nil
+		case taskStatusError, taskStatusTerminated, taskStatusTempError:
+			return taskPoll{}, res, &taskFailedError{Task: task, Status: *completion}
+		}
+		return taskPoll{Status: *completion}, res, nil
+	})
+}
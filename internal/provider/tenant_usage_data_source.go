@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+var (
+	_ datasource.DataSource              = &tenantUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &tenantUsageDataSource{}
+)
+
+func NewTenantUsageDataSource() datasource.DataSource {
+	return &tenantUsageDataSource{}
+}
+
+type tenantUsageDataSource struct {
+	data *providerData
+}
+
+type tenantUsageDataSourceModel struct {
+	APIVersion         types.String                `tfsdk:"api_version"`
+	IdentityCount      types.Int64                 `tfsdk:"identity_count"`
+	SourceCount        types.Int64                 `tfsdk:"source_count"`
+	SourcesByConnector []tenantUsageConnectorModel `tfsdk:"sources_by_connector"`
+	Licenses           []tenantUsageLicenseModel   `tfsdk:"licenses"`
+}
+
+type tenantUsageConnectorModel struct {
+	Connector types.String `tfsdk:"connector"`
+	Count     types.Int64  `tfsdk:"count"`
+}
+
+type tenantUsageLicenseModel struct {
+	Product           types.String `tfsdk:"product"`
+	LicenseID         types.String `tfsdk:"license_id"`
+	LegacyFeatureName types.String `tfsdk:"legacy_feature_name"`
+}
+
+func (d *tenantUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_usage"
+}
+
+func (d *tenantUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Usage metrics of the tenant, its identity and source counts and the licenses of its products, to track the licensing posture of the tenant. Every source is listed to count them per connector.",
+		Attributes: map[string]schema.Attribute{
+			"api_version": apiVersionDataSourceSchemaAttribute(apiVersionsSinceV2024),
+			"identity_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of identities of the tenant.",
+			},
+			"source_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of sources of the tenant.",
+			},
+			"sources_by_connector": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Number of sources of each connector, sorted by connector.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connector": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the connector (ex. Active Directory).",
+						},
+						"count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of sources of the connector.",
+						},
+					},
+				},
+			},
+			"licenses": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Licenses of the products of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"product": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the product (ex. idn).",
+						},
+						"license_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the license.",
+						},
+						"legacy_feature_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Legacy name of the feature the license grants.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tenantUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring SailPoint TenantUsage data resource")
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func (d *tenantUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading Tenant Usage")
+	defer d.data.rateLimit.warnRateLimit(&resp.Diagnostics)
+	var state tenantUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.data.clientFor(state.APIVersion, apiVersionsSinceV2024, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identities := paginationModel{CountOnly: types.BoolValue(true)}
+	_, res, err := paginate[api_v2025.Identity](client.V2025.IdentitiesAPI.ListIdentities(ctx), &identities)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Identity Count", err, res)
+		return
+	}
+	state.IdentityCount = identities.Total
+
+	sources, res, err := paginate[api_v2025.Source](client.V2025.SourcesAPI.ListSources(ctx), &paginationModel{MaxResults: types.Int64Value(0)})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Sources", err, res)
+		return
+	}
+	state.SourceCount = types.Int64Value(int64(len(sources)))
+	state.SourcesByConnector = countSourcesByConnector(sources)
+
+	tenant, res, err := client.V2025.TenantAPI.GetTenant(ctx).Execute()
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, "Unable to Read Tenant", err, res)
+		return
+	}
+	state.Licenses = make([]tenantUsageLicenseModel, 0)
+	for _, product := range tenant.Products {
+		for _, license := range product.Licenses {
+			state.Licenses = append(state.Licenses, tenantUsageLicenseModel{
+				Product:           types.StringPointerValue(product.ProductName),
+				LicenseID:         types.StringPointerValue(license.LicenseId),
+				LegacyFeatureName: types.StringPointerValue(license.LegacyFeatureName),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// countSourcesByConnector counts sources per connector name, falling back to
+// the connector ID of the sources which don't report their connector name.
+func countSourcesByConnector(sources []api_v2025.Source) []tenantUsageConnectorModel {
+	counts := make(map[string]int64)
+	for _, source := range sources {
+		connector := source.GetConnectorName()
+		if connector == "" {
+			connector = source.Connector
+		}
+		counts[connector]++
+	}
+
+	models := make([]tenantUsageConnectorModel, 0, len(counts))
+	for _, connector := range slices.Sorted(maps.Keys(counts)) {
+		models = append(models, tenantUsageConnectorModel{
+			Connector: types.StringValue(connector),
+			Count:     types.Int64Value(counts[connector]),
+		})
+	}
+	return models
+}
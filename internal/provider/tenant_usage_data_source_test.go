@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/sailpoint-oss/golang-sdk/v2/api_v2025"
+)
+
+func TestCountSourcesByConnector(t *testing.T) {
+	sources := []api_v2025.Source{
+		{Connector: "active-directory", ConnectorName: api_v2025.PtrString("Active Directory")},
+		{Connector: "delimited-file"},
+		{Connector: "active-directory", ConnectorName: api_v2025.PtrString("Active Directory")},
+	}
+
+	counts := countSourcesByConnector(sources)
+	if len(counts) != 2 {
+		t.Fatalf("expected 2 connectors, got %+v", counts)
+	}
+	if counts[0].Connector.ValueString() != "Active Directory" || counts[0].Count.ValueInt64() != 2 {
+		t.Errorf("unexpected count %+v", counts[0])
+	}
+	if counts[1].Connector.ValueString() != "delimited-file" || counts[1].Count.ValueInt64() != 1 {
+		t.Errorf("unexpected count %+v", counts[1])
+	}
+}
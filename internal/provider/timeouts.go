@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeoutsResourceSchemaBlock is the timeouts block of the resources whose
+// operations can take long, following the conventions of the timeouts blocks
+// of other providers: each operation takes a duration such as 30s or 10m.
+var timeoutsResourceSchemaBlock = schema.SingleNestedBlock{
+	Description: "Maximum duration of each operation on the resource, as a duration such as 30s or 10m.",
+	Attributes: map[string]schema.Attribute{
+		"create": schema.StringAttribute{
+			Optional:    true,
+			Validators:  []validator.String{durationValidator{}},
+			Description: "Maximum duration of the creation, including the wait for the object to be ready.",
+		},
+		"read": schema.StringAttribute{
+			Optional:    true,
+			Validators:  []validator.String{durationValidator{}},
+			Description: "Maximum duration of a refresh.",
+		},
+		"update": schema.StringAttribute{
+			Optional:    true,
+			Validators:  []validator.String{durationValidator{}},
+			Description: "Maximum duration of an update.",
+		},
+		"delete": schema.StringAttribute{
+			Optional:    true,
+			Validators:  []validator.String{durationValidator{}},
+			Description: "Maximum duration of the deletion.",
+		},
+	},
+}
+
+// timeoutsModel maps the timeouts block, a nil model meaning the block is
+// absent and every operation uses its default.
+type timeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+func (t *timeoutsModel) create(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+	return timeoutValue(t.Create, defaultTimeout)
+}
+
+func (t *timeoutsModel) read(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+	return timeoutValue(t.Read, defaultTimeout)
+}
+
+func (t *timeoutsModel) update(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+	return timeoutValue(t.Update, defaultTimeout)
+}
+
+func (t *timeoutsModel) delete(defaultTimeout time.Duration) time.Duration {
+	if t == nil {
+		return defaultTimeout
+	}
+	return timeoutValue(t.Delete, defaultTimeout)
+}
+
+// timeoutValue parses a configured timeout, which durationValidator already
+// checked at plan time.
+func timeoutValue(value types.String, defaultTimeout time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultTimeout
+	}
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return defaultTimeout
+	}
+	return timeout
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// tokenExpiryMargin is how long a cached token must still be valid to
+	// be reused, so it doesn't expire while a request is in flight. Tokens
+	// are refreshed during the run, see refreshingTokenSource.
+	tokenExpiryMargin = time.Minute
+
+	tokenCacheLockTimeout = 30 * time.Second
+	tokenCacheLockStale   = 2 * time.Minute
+)
+
+// memoryTokenCache holds the tokens requested by every provider instance in
+// this process, so aliased provider blocks pointing at the same client share
+// a token. Each key has its own lock, so fetching the token of a client
+// doesn't hold back the other clients.
+var memoryTokenCache = struct {
+	sync.Mutex
+	entries map[string]*tokenCacheEntry
+}{entries: map[string]*tokenCacheEntry{}}
+
+// tokenCacheEntry is the cached token of a key, locked while it is read or
+// replaced.
+type tokenCacheEntry struct {
+	sync.Mutex
+	token *oauth2.Token
+}
+
+// cachedTokenEntry returns the cache entry of key, creating it if needed.
+func cachedTokenEntry(key string) *tokenCacheEntry {
+	memoryTokenCache.Lock()
+	defer memoryTokenCache.Unlock()
+
+	entry, ok := memoryTokenCache.entries[key]
+	if !ok {
+		entry = &tokenCacheEntry{}
+		memoryTokenCache.entries[key] = entry
+	}
+	return entry
+}
+
+// tokenCacheKey identifies the tokens issued to a client for a given set of
+// scopes. The secret is mixed in hashed, so a rotated or mistyped secret
+// doesn't reuse the token of another, while the key, used in file names,
+// doesn't reveal it.
+func tokenCacheKey(config clientcredentials.Config) string {
+	secret := sha256.Sum256([]byte(config.ClientSecret))
+	scopes := strings.Join(config.Scopes, " ")
+	sum := sha256.Sum256([]byte(config.TokenURL + "\n" + config.ClientID + "\n" + hex.EncodeToString(secret[:]) + "\n" + scopes))
+	return hex.EncodeToString(sum[:])
+}
+
+func tokenUsable(token *oauth2.Token) bool {
+	return token != nil && token.AccessToken != "" && (token.Expiry.IsZero() || time.Until(token.Expiry) > tokenExpiryMargin)
+}
+
+// requestAccessToken returns an access token for the client credentials,
+// reusing a cached one when it is still valid. When cacheDir is set, tokens
+// are also cached on disk so separate Terraform runs can share them.
+func requestAccessToken(ctx context.Context, config clientcredentials.Config, cacheDir string) (*oauth2.Token, error) {
+	key := tokenCacheKey(config)
+	entry := cachedTokenEntry(key)
+
+	entry.Lock()
+	defer entry.Unlock()
+
+	if token := entry.token; tokenUsable(token) {
+		tflog.Debug(ctx, "Reusing SailPoint access token from memory cache")
+		return token, nil
+	}
+
+	fetch := config.Token
+	if cacheDir != "" {
+		fetch = func(ctx context.Context) (*oauth2.Token, error) {
+			return requestDiskCachedToken(ctx, config, filepath.Join(cacheDir, key+".json"))
+		}
+	}
+
+	token, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.token = token
+	return token, nil
+}
+
+// invalidateAccessToken drops a token the API rejected from the caches, so
+// the next requestAccessToken requests a new one. Tokens which already
+// replaced it are kept.
+func invalidateAccessToken(ctx context.Context, config clientcredentials.Config, cacheDir string, token *oauth2.Token) {
+	key := tokenCacheKey(config)
+	entry := cachedTokenEntry(key)
+
+	entry.Lock()
+	defer entry.Unlock()
+
+	if entry.token != nil && entry.token.AccessToken == token.AccessToken {
+		entry.token = nil
+	}
+	if cacheDir == "" {
+		return
+	}
+
+	path := filepath.Join(cacheDir, key+".json")
+	unlock, err := lockFile(ctx, path+".lock")
+	if err != nil {
+		tflog.Warn(ctx, "Unable to remove SailPoint access token from disk cache", map[string]any{"path": path, "error": err.Error()})
+		return
+	}
+	defer unlock()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	var cached cachedToken
+	if err := json.Unmarshal(content, &cached); err != nil || cached.AccessToken == token.AccessToken {
+		os.Remove(path)
+	}
+}
+
+// tokenSource hands out the access token sent with the API requests, see
+// authTransport.
+type tokenSource interface {
+	token(ctx context.Context) (*oauth2.Token, error)
+	// invalidate discards a token the API rejected, reporting whether a new
+	// one can be requested.
+	invalidate(ctx context.Context, token *oauth2.Token) bool
+}
+
+// staticTokenSource hands out the pre-acquired access_token, which the
+// provider has no way to refresh.
+type staticTokenSource struct {
+	accessToken string
+}
+
+func (s staticTokenSource) token(context.Context) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: s.accessToken, TokenType: "Bearer"}, nil
+}
+
+func (s staticTokenSource) invalidate(context.Context, *oauth2.Token) bool {
+	return false
+}
+
+// refreshingTokenSource hands out the token of the client credentials,
+// requesting a new one when it is about to expire or was rejected, so runs
+// outlasting a token, such as a cluster creation, keep authenticating. The
+// caches of requestAccessToken make every call after the first cheap.
+type refreshingTokenSource struct {
+	config     clientcredentials.Config
+	cacheDir   string
+	httpClient *http.Client
+	timeout    time.Duration
+}
+
+func (s *refreshingTokenSource) token(ctx context.Context) (*oauth2.Token, error) {
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, oauth2.HTTPClient, s.httpClient), s.timeout)
+	defer cancel()
+
+	return requestAccessToken(ctx, s.config, s.cacheDir)
+}
+
+func (s *refreshingTokenSource) invalidate(ctx context.Context, token *oauth2.Token) bool {
+	tflog.Debug(ctx, "SailPoint access token rejected, requesting a new one")
+	invalidateAccessToken(ctx, s.config, s.cacheDir, token)
+	return true
+}
+
+// cachedToken is the on-disk representation of a token.
+type cachedToken struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+func requestDiskCachedToken(ctx context.Context, config clientcredentials.Config, path string) (*oauth2.Token, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("creating token cache directory: %w", err)
+	}
+
+	unlock, err := lockFile(ctx, path+".lock")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if content, err := os.ReadFile(path); err == nil {
+		var cached cachedToken
+		if err := json.Unmarshal(content, &cached); err == nil {
+			token := &oauth2.Token{AccessToken: cached.AccessToken, TokenType: cached.TokenType, Expiry: cached.Expiry}
+			if tokenUsable(token) {
+				tflog.Debug(ctx, "Reusing SailPoint access token from disk cache", map[string]any{"path": path})
+				return token, nil
+			}
+		}
+	}
+
+	token, err := config.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := json.Marshal(cachedToken{AccessToken: token.AccessToken, TokenType: token.TokenType, Expiry: token.Expiry})
+	if err != nil {
+		return nil, err
+	}
+
+	// A token that can't be cached is still usable, so failing to write it
+	// only warrants a warning.
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		tflog.Warn(ctx, "Unable to write SailPoint access token to disk cache", map[string]any{"path": path, "error": err.Error()})
+	}
+
+	return token, nil
+}
+
+// lockFile takes an exclusive lock by creating the lock file, which works
+// across processes and platforms. Locks older than tokenCacheLockStale are
+// considered abandoned by a crashed process and removed.
+func lockFile(ctx context.Context, path string) (func(), error) {
+	deadline := time.Now().Add(tokenCacheLockTimeout)
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("locking token cache: %w", err)
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > tokenCacheLockStale {
+			os.Remove(path)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for the token cache lock %s", path)
+		}
+
+		if err := sleepContext(ctx, 100*time.Millisecond); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func newTestTokenServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":43199}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRequestAccessTokenMemoryCache(t *testing.T) {
+	requests := 0
+	server := newTestTokenServer(t, &requests)
+
+	config := clientcredentials.Config{ClientID: "memory", ClientSecret: "secret", TokenURL: server.URL}
+	for range 3 {
+		token, err := requestAccessToken(context.Background(), config, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if token.AccessToken != "token" {
+			t.Errorf("unexpected token %q", token.AccessToken)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 token request, got %d", requests)
+	}
+}
+
+func TestRequestAccessTokenDiskCache(t *testing.T) {
+	requests := 0
+	server := newTestTokenServer(t, &requests)
+	dir := t.TempDir()
+
+	config := clientcredentials.Config{ClientID: "disk", ClientSecret: "secret", TokenURL: server.URL}
+	for range 2 {
+		// Simulate separate runs by clearing the memory cache.
+		memoryTokenCache.Lock()
+		delete(memoryTokenCache.entries, tokenCacheKey(config))
+		memoryTokenCache.Unlock()
+
+		if _, err := requestAccessToken(context.Background(), config, dir); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 token request, got %d", requests)
+	}
+}
+
+func TestRequestAccessTokenSecretChange(t *testing.T) {
+	requests := 0
+	server := newTestTokenServer(t, &requests)
+	dir := t.TempDir()
+
+	config := clientcredentials.Config{ClientID: "rotated", ClientSecret: "secret", TokenURL: server.URL}
+	if _, err := requestAccessToken(context.Background(), config, dir); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	config.ClientSecret = "rotated-secret"
+	if _, err := requestAccessToken(context.Background(), config, dir); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected a token request per secret, got %d", requests)
+	}
+	if key := tokenCacheKey(config); strings.Contains(key, "rotated-secret") {
+		t.Errorf("the cache key %q reveals the secret", key)
+	}
+}
+
+func TestProviderDataNewAccessToken(t *testing.T) {
+	requests := 0
+	server := newTestTokenServer(t, &requests)
+
+	data := &providerData{
+		oauthConfig:  &clientcredentials.Config{ClientID: "ephemeral", ClientSecret: "secret", TokenURL: server.URL},
+		httpClient:   server.Client(),
+		tokenTimeout: defaultTokenTimeout,
+	}
+	for range 2 {
+		token, err := data.newAccessToken(context.Background(), []string{"sp:scopes:all"})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if token.AccessToken != "token" {
+			t.Errorf("unexpected token %q", token.AccessToken)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected a new token per call, got %d requests", requests)
+	}
+
+	data = &providerData{accessToken: "configured"}
+	if token, err := data.newAccessToken(context.Background(), nil); err != nil || token.AccessToken != "configured" {
+		t.Errorf("unexpected token %v, error %v", token, err)
+	}
+	if _, err := data.newAccessToken(context.Background(), []string{"sp:scopes:all"}); err == nil {
+		t.Error("expected an error requesting scopes without client credentials")
+	}
+}
+
+func TestRefreshingTokenSourceExpiry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		// Tokens expiring within tokenExpiryMargin are refreshed.
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"bearer","expires_in":30}`))
+	}))
+	defer server.Close()
+
+	tokens := &refreshingTokenSource{
+		config:     clientcredentials.Config{ClientID: "expiring", ClientSecret: "secret", TokenURL: server.URL},
+		httpClient: server.Client(),
+		timeout:    defaultTokenTimeout,
+	}
+	for range 2 {
+		if _, err := tokens.token(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("expected a new token per call, got %d requests", requests)
+	}
+}
+
+func TestInvalidateAccessToken(t *testing.T) {
+	requests := 0
+	server := newTestTokenServer(t, &requests)
+	dir := t.TempDir()
+
+	config := clientcredentials.Config{ClientID: "invalidated", ClientSecret: "secret", TokenURL: server.URL}
+	token, err := requestAccessToken(context.Background(), config, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	invalidateAccessToken(context.Background(), config, dir, token)
+	if _, err := requestAccessToken(context.Background(), config, dir); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected the invalidated token to be requested again, got %d requests", requests)
+	}
+}
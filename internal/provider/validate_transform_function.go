@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = validateTransformFunction{}
+)
+
+// transformRequiredAttributes lists the transform types documented by
+// SailPoint with the attributes each of them requires.
+var transformRequiredAttributes = map[string][]string{
+	"accountAttribute":              {"sourceName", "attributeName"},
+	"base64Decode":                  nil,
+	"base64Encode":                  nil,
+	"concat":                        {"values"},
+	"conditional":                   {"expression", "positiveCondition", "negativeCondition"},
+	"dateCompare":                   {"firstDate", "secondDate", "operator", "positiveCondition", "negativeCondition"},
+	"dateFormat":                    nil,
+	"dateMath":                      {"expression"},
+	"decomposeDiacriticalMarks":     nil,
+	"displayName":                   nil,
+	"e164phone":                     nil,
+	"firstValid":                    {"values"},
+	"getEndOfString":                {"numChars"},
+	"getReferenceIdentityAttribute": {"operation", "uid", "attributeName"},
+	"identityAttribute":             {"name"},
+	"indexOf":                       {"substring"},
+	"iso3166":                       nil,
+	"lastIndexOf":                   {"substring"},
+	"leftPad":                       {"length"},
+	"lookup":                        {"table"},
+	"lower":                         nil,
+	"normalizeNames":                nil,
+	"randomAlphaNumeric":            nil,
+	"randomNumeric":                 nil,
+	"reference":                     {"id"},
+	"replace":                       {"regex", "replacement"},
+	"replaceAll":                    {"table"},
+	"rfc5646":                       nil,
+	"rightPad":                      {"length"},
+	"rule":                          {"name"},
+	"split":                         {"delimiter", "index"},
+	"static":                        {"value"},
+	"substring":                     {"begin"},
+	"trim":                          nil,
+	"upper":                         nil,
+	"usernameGenerator":             {"patterns"},
+	"uuid":                          nil,
+}
+
+func NewValidateTransformFunction() function.Function {
+	return validateTransformFunction{}
+}
+
+// validateTransformFunction checks a transform definition before it is sent
+// to the API, which only reports the first problem it finds and only at
+// apply time.
+type validateTransformFunction struct{}
+
+func (f validateTransformFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_transform"
+}
+
+func (f validateTransformFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a transform definition",
+		MarkdownDescription: "Validates a transform definition JSON (ex. `jsonencode({ name = ..., type = ..., attributes = {...} })`) at plan time, " +
+			"checking that it and every nested transform has a known type and the attributes that type requires. " +
+			"Returns the definition unchanged so it can be used inline, and fails with every problem found otherwise.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "definition",
+				MarkdownDescription: "Transform definition JSON.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f validateTransformFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var definition string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &definition))
+	if resp.Error != nil {
+		return
+	}
+
+	if problems := validateTransformDefinition(definition); len(problems) > 0 {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid transform definition:\n  - "+strings.Join(problems, "\n  - "))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, definition))
+}
+
+// validateTransformDefinition returns the problems found in a transform
+// definition JSON, an empty result meaning it is valid.
+func validateTransformDefinition(definition string) []string {
+	var transform map[string]any
+	if err := json.Unmarshal([]byte(definition), &transform); err != nil {
+		return []string{fmt.Sprintf("the definition is not a JSON object: %s", err)}
+	}
+
+	var problems []string
+	if name, _ := transform["name"].(string); name == "" {
+		problems = append(problems, "name: a transform requires a name")
+	}
+	return append(problems, validateTransform(transform, "")...)
+}
+
+// validateTransform checks a transform object and the transforms nested in
+// its attributes. path locates the transform in the definition.
+func validateTransform(transform map[string]any, path string) []string {
+	at := func(attribute string) string {
+		if path == "" {
+			return attribute
+		}
+		return path + "." + attribute
+	}
+
+	transformType, _ := transform["type"].(string)
+	if transformType == "" {
+		return []string{at("type") + ": a transform requires a type"}
+	}
+
+	required, known := transformRequiredAttributes[transformType]
+	if !known {
+		return []string{fmt.Sprintf("%s: unknown transform type %q", at("type"), transformType)}
+	}
+
+	var problems []string
+
+	attributes, _ := transform["attributes"].(map[string]any)
+	if _, ok := transform["attributes"]; ok && attributes == nil {
+		problems = append(problems, at("attributes")+": the attributes of a transform must be an object")
+	}
+	for _, attribute := range required {
+		if value, ok := attributes[attribute]; !ok || value == nil {
+			problems = append(problems, fmt.Sprintf("%s: the %s transform requires the %s attribute", at("attributes"), transformType, attribute))
+		}
+	}
+	if transformType == "concat" || transformType == "firstValid" {
+		if values, ok := attributes["values"]; ok {
+			if _, ok := values.([]any); !ok {
+				problems = append(problems, fmt.Sprintf("%s: the values of a %s transform must be a list", at("attributes.values"), transformType))
+			}
+		}
+	}
+
+	// Nested transforms are found in attribute values (ex. input) and in
+	// lists of values (ex. concat and firstValid). Lookup tables are plain
+	// maps which may well have a "type" key.
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		if name != "table" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		switch value := attributes[name].(type) {
+		case map[string]any:
+			if isNestedTransform(value) {
+				problems = append(problems, validateTransform(value, at("attributes."+name))...)
+			}
+		case []any:
+			for i, element := range value {
+				if nested, ok := element.(map[string]any); ok && isNestedTransform(nested) {
+					problems = append(problems, validateTransform(nested, fmt.Sprintf("%s[%d]", at("attributes."+name), i))...)
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// isNestedTransform tells nested transforms apart from plain object
+// attributes such as lookup tables.
+func isNestedTransform(value map[string]any) bool {
+	_, hasType := value["type"].(string)
+	return hasType
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValidateTransformDefinition(t *testing.T) {
+	tests := map[string]struct {
+		definition string
+		want       []string
+	}{
+		"valid": {
+			definition: `{"name":"Email","type":"lower","attributes":{"input":{"type":"concat","attributes":{"values":[{"type":"identityAttribute","attributes":{"name":"firstname"}},".",{"type":"accountAttribute","attributes":{"sourceName":"HR","attributeName":"last"}}]}}}}`,
+		},
+		"lookup table": {
+			definition: `{"name":"Region","type":"lookup","attributes":{"table":{"type":"x","default":"y"}}}`,
+		},
+		"not json": {
+			definition: `{"name":`,
+			want:       []string{"the definition is not a JSON object: unexpected end of JSON input"},
+		},
+		"missing name and type": {
+			definition: `{"attributes":{}}`,
+			want:       []string{"name: a transform requires a name", "type: a transform requires a type"},
+		},
+		"unknown type": {
+			definition: `{"name":"x","type":"camelCase"}`,
+			want:       []string{`type: unknown transform type "camelCase"`},
+		},
+		"nested problems": {
+			definition: `{"name":"x","type":"firstValid","attributes":{"values":[{"type":"static","attributes":{}},{"type":"split","attributes":{"delimiter":","}}]}}`,
+			want: []string{
+				"attributes.values[0].attributes: the static transform requires the value attribute",
+				"attributes.values[1].attributes: the split transform requires the index attribute",
+			},
+		},
+		"values not a list": {
+			definition: `{"name":"x","type":"concat","attributes":{"values":"a"}}`,
+			want:       []string{"attributes.values: the values of a concat transform must be a list"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := validateTransformDefinition(test.definition); !slices.Equal(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
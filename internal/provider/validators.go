@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ validator.Int64  = int64RangeValidator{}
+	_ validator.String = stringPatternValidator{}
+	_ validator.List   = stringPatternValidator{}
+	_ validator.String = durationValidator{}
+	_ validator.String = stringOneOfValidator{}
+	_ validator.String = sailPointIDValidator{}
+	_ validator.List   = sailPointIDValidator{}
+)
+
+// int64RangeValidator rejects values outside [min, max], a zero max meaning
+// no upper bound.
+type int64RangeValidator struct {
+	min int64
+	max int64
+}
+
+func (v int64RangeValidator) Description(_ context.Context) string {
+	if v.max == 0 {
+		return fmt.Sprintf("value must be at least %d", v.min)
+	}
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64RangeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	maxValue := v.max
+	if maxValue == 0 {
+		maxValue = math.MaxInt64
+	}
+	if value := req.ConfigValue.ValueInt64(); value < v.min || value > maxValue {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// stringPatternValidator rejects values which don't match pattern, message
+// describing the expected format. On lists, every element is checked.
+type stringPatternValidator struct {
+	pattern *regexp.Regexp
+	message string
+}
+
+func (v stringPatternValidator) Description(_ context.Context) string {
+	return "value " + v.message
+}
+
+func (v stringPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringPatternValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueString(); !v.pattern.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+func (v stringPatternValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if !v.pattern.MatchString(value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s %s, got: %q", req.Path.AtListIndex(i), v.Description(ctx), value.ValueString()),
+			)
+		}
+	}
+}
+
+// durationValidator rejects values which are not positive Go durations, such
+// as 30s, 10m or 1h30m.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as 30s, 10m or 1h30m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if duration, err := time.ParseDuration(value); err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// stringOneOfValidator rejects values other than the given ones.
+type stringOneOfValidator struct {
+	values []string
+}
+
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return "value must be one of " + strings.Join(v.values, ", ")
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueString(); !slices.Contains(v.values, value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// sailPointIDPattern matches the IDs of the objects of a tenant, 32 hex
+// digits, with the dashes of a UUID for some object types.
+var sailPointIDPattern = regexp.MustCompile(`^(?:[0-9a-fA-F]{32}|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})$`)
+
+// sailPointIDValidator rejects references to objects which are not well
+// formed IDs, so a name or a mistyped ID fails at plan time instead of as a
+// 404 or 400 response in the middle of an apply. On lists and sets, every
+// element is checked.
+type sailPointIDValidator struct{}
+
+func (v sailPointIDValidator) Description(_ context.Context) string {
+	return "value must be an object ID, 32 hexadecimal digits or a UUID"
+}
+
+func (v sailPointIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sailPointIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueString(); !sailPointIDPattern.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Object ID",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+func (v sailPointIDValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if !sailPointIDPattern.MatchString(value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid Object ID",
+				fmt.Sprintf("Attribute %s %s, got: %q", req.Path.AtListIndex(i), v.Description(ctx), value.ValueString()),
+			)
+		}
+	}
+}
+
+func (v sailPointIDValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if !sailPointIDPattern.MatchString(value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(value),
+				"Invalid Object ID",
+				fmt.Sprintf("Attribute %s %s, got: %q", req.Path.AtSetValue(value), v.Description(ctx), value.ValueString()),
+			)
+		}
+	}
+}
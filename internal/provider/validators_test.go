@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSailPointIDValidator(t *testing.T) {
+	tests := map[string]bool{
+		"2c9180835d2e5168015d32f890ca1581":     true,
+		"2C9180835D2E5168015D32F890CA1581":     true,
+		"a1b2c3d4-e5f6-7890-abcd-ef1234567890": true,
+		"":                                     false,
+		"Production cluster":                   false,
+		"2c9180835d2e5168015d32f890ca158":      false,
+		"2c9180835d2e5168015d32f890ca1581 ":    false,
+	}
+
+	for id, valid := range tests {
+		resp := &validator.StringResponse{}
+		sailPointIDValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("id"),
+			ConfigValue: types.StringValue(id),
+		}, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%q: expected valid %t, got %v", id, valid, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"sync"
+)
+
+// detailFetchWorkers bounds the per-object requests a plural data source
+// sends at once. Each of them still goes through the provider's HTTP client,
+// so max_concurrent_requests and the rate limit retries apply as usual.
+const detailFetchWorkers = 8
+
+// forEachConcurrently calls fn for every index in [0, n) from at most workers
+// goroutines. After the first failure, the context passed to the remaining
+// calls is cancelled and the indexes not started yet are skipped. It returns
+// the index and error of the first failure, or -1 and nil. The index is also
+// -1 when parent was cancelled before every call was made.
+func forEachConcurrently(parent context.Context, n, workers int, fn func(ctx context.Context, i int) error) (int, error) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	var once sync.Once
+	failedIndex, failedErr := -1, error(nil)
+
+	for range max(1, min(workers, n)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if ctx.Err() != nil {
+					continue
+				}
+				if err := fn(ctx, i); err != nil {
+					once.Do(func() {
+						failedIndex, failedErr = i, err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+send:
+	for i := range n {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if failedErr == nil && parent.Err() != nil {
+		return -1, parent.Err()
+	}
+	return failedIndex, failedErr
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	var running, peak, calls atomic.Int32
+	results := make([]int, 20)
+
+	failed, err := forEachConcurrently(context.Background(), len(results), 4, func(_ context.Context, i int) error {
+		calls.Add(1)
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if current <= p || peak.CompareAndSwap(p, current) {
+				break
+			}
+		}
+		results[i] = i * 2
+		return nil
+	})
+
+	if failed != -1 || err != nil {
+		t.Fatalf("unexpected failure at %d: %v", failed, err)
+	}
+	if calls.Load() != 20 || peak.Load() > 4 {
+		t.Errorf("got %d calls with %d running at once", calls.Load(), peak.Load())
+	}
+	for i, result := range results {
+		if result != i*2 {
+			t.Errorf("result %d was not set", i)
+		}
+	}
+}
+
+func TestForEachConcurrentlyFailure(t *testing.T) {
+	errFailed := errors.New("failed")
+	var calls atomic.Int32
+
+	failed, err := forEachConcurrently(context.Background(), 100, 1, func(_ context.Context, i int) error {
+		calls.Add(1)
+		if i == 3 {
+			return errFailed
+		}
+		return nil
+	})
+
+	if failed != 3 || !errors.Is(err, errFailed) {
+		t.Errorf("got failure at %d: %v", failed, err)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("expected the remaining calls to be skipped, got %d calls", calls.Load())
+	}
+}